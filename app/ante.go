@@ -21,7 +21,6 @@ import (
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmTypes "github.com/CosmWasm/wasmd/x/wasm/types"
-	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
 	ethermintante "github.com/evmos/ethermint/app/ante"
 	etherminttypes "github.com/evmos/ethermint/types"
 	evmtypes "github.com/evmos/ethermint/x/evm/types"
@@ -33,6 +32,8 @@ type HandlerOptions struct {
 	authante.HandlerOptions
 
 	IBCKeeper *keeper.Keeper
+	// IBCPriorityBoost raises the priority of relayer txs, in percent
+	IBCPriorityBoost uint64
 
 	// CosmWasm
 	WasmConfig            *wasmTypes.WasmConfig
@@ -41,10 +42,39 @@ type HandlerOptions struct {
 	CircuitKeeper         *circuitkeeper.Keeper
 
 	// Ethermint
-	FeeMarketKeeper   ethermintante.FeeMarketKeeper
-	EvmKeeper         ethermintante.EVMKeeper
-	DisabledAuthzMsgs []string
-	MaxTxGasWanted    uint64
+	FeeMarketKeeper ethermintante.FeeMarketKeeper
+	EvmKeeper       ethermintante.EVMKeeper
+	MaxTxGasWanted  uint64
+
+	// MsgFilter rejects the message types disabled by governance and returns
+	// the fees of the message types
+	MsgFilter MsgFilter
+	// DeployerKeeper rejects the contract creation txs of the senders not
+	// allowed to deploy contracts, and the unprotected txs not allowlisted
+	DeployerKeeper DeployerKeeper
+	// SponsorKeeper returns the sponsors paying the fees of the Ethereum txs
+	// calling their contracts
+	SponsorKeeper SponsorKeeper
+	// FeeAbsKeeper converts the fees of Cosmos txs paid in other denoms than the
+	// base denom, which go to the community pool of DistrKeeper
+	FeeAbsKeeper FeeAbsKeeper
+	DistrKeeper  CommunityPoolKeeper
+	// BlocklistKeeper rejects the txs of blocked addresses, and Codec returns
+	// the signers of the messages nested in the txs
+	BlocklistKeeper BlocklistKeeper
+	Codec           MsgSignersGetter
+	// StakingKeeper returns the minimum commission rate of the validators
+	StakingKeeper MinCommissionKeeper
+	// SmartAccountKeeper validates the txs of the smart accounts with the hooks
+	// of their contracts, on the sign bytes of the txs as encoded by TxEncoder
+	SmartAccountKeeper SmartAccountKeeper
+	TxEncoder          sdk.TxEncoder
+
+	// Mempool is the app-side mempool, used to accept replace-by-fee Ethereum txs
+	// and to drop evicted txs on ReCheckTx
+	Mempool *TacMempool
+	// SigCache caches the senders of Ethereum txs recovered in CheckTx
+	SigCache *SigCache
 }
 
 // NewAnteHandler returns an ante handler responsible for attempting to route an
@@ -76,6 +106,36 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	if options.EvmKeeper == nil {
 		return nil, errors.New("evm keeper is required for ante builder")
 	}
+	if options.MsgFilter == nil {
+		return nil, errors.New("msg filter is required for ante builder")
+	}
+	if options.DeployerKeeper == nil {
+		return nil, errors.New("deployer keeper is required for ante builder")
+	}
+	if options.SponsorKeeper == nil {
+		return nil, errors.New("sponsor keeper is required for ante builder")
+	}
+	if options.FeeAbsKeeper == nil {
+		return nil, errors.New("feeabs keeper is required for ante builder")
+	}
+	if options.DistrKeeper == nil {
+		return nil, errors.New("distribution keeper is required for ante builder")
+	}
+	if options.BlocklistKeeper == nil {
+		return nil, errors.New("blocklist keeper is required for ante builder")
+	}
+	if options.Codec == nil {
+		return nil, errors.New("codec is required for ante builder")
+	}
+	if options.StakingKeeper == nil {
+		return nil, errors.New("staking keeper is required for ante builder")
+	}
+	if options.SmartAccountKeeper == nil {
+		return nil, errors.New("smartaccount keeper is required for ante builder")
+	}
+	if options.TxEncoder == nil {
+		return nil, errors.New("tx encoder is required for ante builder")
+	}
 
 	return func(
 		ctx sdk.Context, tx sdk.Tx, sim bool,
@@ -84,16 +144,6 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 
 		defer ethermintante.Recover(ctx.Logger(), &err)
 
-		// disable vesting message types
-		for _, msg := range tx.GetMsgs() {
-			switch msg.(type) {
-			case *vestingtypes.MsgCreateVestingAccount,
-				*vestingtypes.MsgCreatePeriodicVestingAccount,
-				*vestingtypes.MsgCreatePermanentLockedAccount:
-				return ctx, errors.New("vesting messages are not supported")
-			}
-		}
-
 		txWithExtensions, ok := tx.(authante.HasExtensionOptionsTx)
 		if ok {
 			opts := txWithExtensions.GetExtensionOptions()
@@ -141,41 +191,74 @@ func newEthAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 	if !ok {
 		return nil, errors.New("account keeper does not implement evmtypes.AccountKeeper")
 	}
+	feegrantKeeper, ok := options.FeegrantKeeper.(EthFeeGrantKeeper)
+	if !ok {
+		return nil, errors.New("fee grant keeper does not implement EthFeeGrantKeeper")
+	}
+	feeGrantEVMKeeper := ethFeeGrantEVMKeeper{
+		EVMKeeper:      options.EvmKeeper,
+		accountKeeper:  options.AccountKeeper,
+		bankKeeper:     options.BankKeeper,
+		feegrantKeeper: feegrantKeeper,
+	}
 
 	return sdk.ChainAnteDecorators(
 		ethermintante.NewEthSetUpContextDecorator(options.EvmKeeper),                         // outermost AnteDecorator. SetUpContext must be called first
+		NewMempoolRecheckDecorator(options.Mempool),                                          // drop txs evicted from the app-side mempool on ReCheckTx
+		NewMsgFilterDecorator(options.MsgFilter),                                             // reject the message types disabled by governance
+		NewMinCommissionDecorator(options.StakingKeeper),                                     // reject the commission rates below the minimum
+		NewEthCircuitBreakerDecorator(options.CircuitKeeper),                                 // reject txs paused by the circuit breaker
 		ethermintante.NewEthMempoolFeeDecorator(options.EvmKeeper),                           // Check eth effective gas price against minimal-gas-prices
 		ethermintante.NewEthMinGasPriceDecorator(options.FeeMarketKeeper, options.EvmKeeper), // Check eth effective gas price against the global MinGasPrice
 		ethermintante.NewEthValidateBasicDecorator(options.EvmKeeper),
-		ethermintante.NewEthSigVerificationDecorator(options.EvmKeeper),
-		ethermintante.NewEthAccountVerificationDecorator(evmAccountKeeper, options.EvmKeeper),
+		NewEthSigVerificationDecorator(options.EvmKeeper, options.DeployerKeeper, options.SigCache),
+		NewEthBlocklistDecorator(options.BlocklistKeeper),              // reject txs sent by or to blocked addresses
+		NewEthDeployerDecorator(options.DeployerKeeper),                // reject contract creations of the senders not allowed to deploy
+		NewEthFeeGrantDecorator(options.SponsorKeeper, feegrantKeeper), // find the fee grant paying for sponsored contracts
+		NewEthAccountVerificationDecorator(evmAccountKeeper, options.EvmKeeper),
 		ethermintante.NewCanTransferDecorator(options.EvmKeeper),
-		ethermintante.NewEthGasConsumeDecorator(options.EvmKeeper, options.MaxTxGasWanted),
-		ethermintante.NewEthIncrementSenderSequenceDecorator(evmAccountKeeper), // innermost AnteDecorator.
+		ethermintante.NewEthGasConsumeDecorator(feeGrantEVMKeeper, options.MaxTxGasWanted), // pay the fees from the fee grant, if any
+		NewEthReplaceByFeeDecorator(evmAccountKeeper, options.Mempool),                     // innermost AnteDecorator.
 		ethermintante.NewGasWantedDecorator(options.EvmKeeper, options.FeeMarketKeeper),
 		ethermintante.NewEthEmitEventDecorator(options.EvmKeeper), // emit eth tx hash and index at the very last ante handler.
 	), nil
 }
 
 func newCosmosAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
+	bankKeeper, ok := options.BankKeeper.(FeeAbsBankKeeper)
+	if !ok {
+		return nil, errors.New("bank keeper does not implement FeeAbsBankKeeper")
+	}
+
 	return sdk.ChainAnteDecorators(
 		authante.NewSetUpContextDecorator(),
+		NewMempoolRecheckDecorator(options.Mempool),
 		wasmkeeper.NewLimitSimulationGasDecorator(options.WasmConfig.SimulationGasLimit), // after setup context to enforce limits early
 		wasmkeeper.NewCountTXDecorator(options.TXCounterStoreService),
 		wasmkeeper.NewGasRegisterDecorator(options.WasmKeeper.GetGasRegister()),
+		NewMsgFilterDecorator(options.MsgFilter),         // reject the message types disabled by governance, including nested ones
+		NewMinCommissionDecorator(options.StakingKeeper), // reject the validators setting a commission rate below the minimum
 		circuitante.NewCircuitBreakerDecorator(options.CircuitKeeper),
 		authante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		authante.NewValidateBasicDecorator(),
+		NewBlocklistDecorator(options.BlocklistKeeper, options.Codec), // reject txs signed or paid by blocked addresses
 		authante.NewTxTimeoutHeightDecorator(),
 		authante.NewValidateMemoDecorator(options.AccountKeeper),
 		authante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		authante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, nil),
-		// SetPubKeyDecorator must be called before all signature verification decorators
-		authante.NewSetPubKeyDecorator(options.AccountKeeper),
-		authante.NewValidateSigCountDecorator(options.AccountKeeper),
-		authante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
-		authante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		// enforce the fee schedule of the message types before deducting the fees
+		NewMsgFeeDecorator(options.MsgFilter, options.FeeAbsKeeper, options.FeeMarketKeeper),
+		NewFeeAbsDecorator(options.AccountKeeper, bankKeeper, options.FeegrantKeeper, options.FeeAbsKeeper, options.DistrKeeper), // deduct the fees, converting the ones paid in other denoms
+		// validate the txs of the smart accounts with their hooks, in place of the
+		// signature verification decorators
+		NewSmartAccountDecorator(options.AccountKeeper, options.SmartAccountKeeper, options.SignModeHandler, options.TxEncoder,
+			// SetPubKeyDecorator must be called before all signature verification decorators
+			authante.NewSetPubKeyDecorator(options.AccountKeeper),
+			authante.NewValidateSigCountDecorator(options.AccountKeeper),
+			authante.NewSigGasConsumeDecorator(options.AccountKeeper, options.SigGasConsumer),
+			NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler), // also verifies the EIP-712 signatures
+		),
 		authante.NewIncrementSequenceDecorator(options.AccountKeeper),
+		NewRelayPriorityDecorator(options.IBCKeeper.ClientKeeper, options.IBCPriorityBoost), // before RedundantRelay, which applies client updates
 		ibcante.NewRedundantRelayDecorator(options.IBCKeeper),
 	), nil
 }
@@ -200,14 +283,39 @@ func newLegacyCosmosAnteHandlerEip712(options HandlerOptions) (sdk.AnteHandler,
 		MaxTxGasWanted:         options.MaxTxGasWanted,
 		ExtensionOptionChecker: etherminttypes.HasDynamicFeeExtensionOption,
 		TxFeeChecker:           ethermintante.NewDynamicFeeChecker(options.EvmKeeper),
-		DisabledAuthzMsgs: []string{
-			sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
-			sdk.MsgTypeURL(&vestingtypes.MsgCreateVestingAccount{}),
-			sdk.MsgTypeURL(&vestingtypes.MsgCreatePermanentLockedAccount{}),
-			sdk.MsgTypeURL(&vestingtypes.MsgCreatePeriodicVestingAccount{}),
-		},
+		// the EVM is called for a granter with the MsgCall of x/evmcall, and the
+		// other disabled msgs are rejected by the MsgFilter
+		DisabledAuthzMsgs: []string{sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})},
 	}
 
-	// Deprecated: Handle as normal Cosmos SDK tx, except signature is checked for Legacy EIP712 representation
-	return ethermintante.NewLegacyCosmosAnteHandlerEip712(ethermintOptions), nil
+	// Deprecated: Handle as normal Cosmos SDK tx, except signature is checked for Legacy EIP712 representation.
+	// The decorators are the ones of ethermint's NewLegacyCosmosAnteHandlerEip712,
+	// with the decorators of the chain after SetUpContext, so that their store
+	// reads are charged to the tx.
+	return sdk.ChainAnteDecorators(
+		ethermintante.RejectMessagesDecorator{}, // reject MsgEthereumTxs
+		// disable the Msg types that cannot be included on an authz.MsgExec msgs field
+		ethermintante.NewAuthzLimiterDecorator(ethermintOptions.DisabledAuthzMsgs),
+		authante.NewSetUpContextDecorator(),
+		NewMempoolRecheckDecorator(options.Mempool),
+		NewMsgFilterDecorator(options.MsgFilter),         // reject the message types disabled by governance, including nested ones
+		NewMinCommissionDecorator(options.StakingKeeper), // reject the validators setting a commission rate below the minimum
+		authante.NewValidateBasicDecorator(),
+		NewBlocklistDecorator(options.BlocklistKeeper, options.Codec), // reject txs signed or paid by blocked addresses
+		authante.NewTxTimeoutHeightDecorator(),
+		ethermintante.NewMinGasPriceDecorator(ethermintOptions.FeeMarketKeeper, ethermintOptions.EvmKeeper),
+		authante.NewValidateMemoDecorator(ethermintOptions.AccountKeeper),
+		authante.NewConsumeGasForTxSizeDecorator(ethermintOptions.AccountKeeper),
+		NewMsgFeeDecorator(options.MsgFilter, nil, options.FeeMarketKeeper), // the fees are deducted in the base denom only
+		authante.NewDeductFeeDecorator(ethermintOptions.AccountKeeper, ethermintOptions.BankKeeper, ethermintOptions.FeegrantKeeper, ethermintOptions.TxFeeChecker),
+		// SetPubKeyDecorator must be called before all signature verification decorators
+		authante.NewSetPubKeyDecorator(ethermintOptions.AccountKeeper),
+		authante.NewValidateSigCountDecorator(ethermintOptions.AccountKeeper),
+		authante.NewSigGasConsumeDecorator(ethermintOptions.AccountKeeper, ethermintOptions.SigGasConsumer),
+		// Note: signature verification uses EIP instead of the cosmos signature validator
+		ethermintante.NewLegacyEip712SigVerificationDecorator(ethermintOptions.AccountKeeper, ethermintOptions.SignModeHandler),
+		authante.NewIncrementSequenceDecorator(ethermintOptions.AccountKeeper),
+		ibcante.NewRedundantRelayDecorator(ethermintOptions.IBCKeeper),
+		ethermintante.NewGasWantedDecorator(ethermintOptions.EvmKeeper, ethermintOptions.FeeMarketKeeper),
+	), nil
 }
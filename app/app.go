@@ -5,13 +5,14 @@
 package app
 
 import (
-	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"sort"
+	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
 	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -49,9 +50,7 @@ import (
 	"cosmossdk.io/client/v2/autocli"
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/log"
-	"cosmossdk.io/simapp"
 	storetypes "cosmossdk.io/store/types"
-	"cosmossdk.io/x/circuit"
 	circuitkeeper "cosmossdk.io/x/circuit/keeper"
 	circuittypes "cosmossdk.io/x/circuit/types"
 	"cosmossdk.io/x/evidence"
@@ -88,7 +87,6 @@ import (
 	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
 	authcodec "github.com/cosmos/cosmos-sdk/x/auth/codec"
 	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
-	"github.com/cosmos/cosmos-sdk/x/auth/posthandler"
 	authsims "github.com/cosmos/cosmos-sdk/x/auth/simulation"
 	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -140,7 +138,6 @@ import (
 	wasmmigrationv2 "github.com/CosmWasm/wasmd/x/wasm/migrations/v2"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
-	mempool "github.com/cosmos/cosmos-sdk/types/mempool"
 	ethermintante "github.com/evmos/ethermint/app/ante"
 	ethermintsrvflags "github.com/evmos/ethermint/server/flags"
 	etherminttypes "github.com/evmos/ethermint/types"
@@ -152,6 +149,38 @@ import (
 	feemarketkeeper "github.com/evmos/ethermint/x/feemarket/keeper"
 	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
 
+	"github.com/Asphere-xyz/tacchain/app/upgrades"
+	"github.com/Asphere-xyz/tacchain/x/blocklist"
+	blocklistkeeper "github.com/Asphere-xyz/tacchain/x/blocklist/keeper"
+	blocklisttypes "github.com/Asphere-xyz/tacchain/x/blocklist/types"
+	"github.com/Asphere-xyz/tacchain/x/deployer"
+	deployerkeeper "github.com/Asphere-xyz/tacchain/x/deployer/keeper"
+	deployertypes "github.com/Asphere-xyz/tacchain/x/deployer/types"
+	"github.com/Asphere-xyz/tacchain/x/evmcall"
+	evmcallkeeper "github.com/Asphere-xyz/tacchain/x/evmcall/keeper"
+	"github.com/Asphere-xyz/tacchain/x/feeabs"
+	feeabskeeper "github.com/Asphere-xyz/tacchain/x/feeabs/keeper"
+	feeabstypes "github.com/Asphere-xyz/tacchain/x/feeabs/types"
+	"github.com/Asphere-xyz/tacchain/x/feeburn"
+	feeburnkeeper "github.com/Asphere-xyz/tacchain/x/feeburn/keeper"
+	feeburntypes "github.com/Asphere-xyz/tacchain/x/feeburn/types"
+	"github.com/Asphere-xyz/tacchain/x/msgfilter"
+	msgfilterkeeper "github.com/Asphere-xyz/tacchain/x/msgfilter/keeper"
+	msgfiltertypes "github.com/Asphere-xyz/tacchain/x/msgfilter/types"
+	"github.com/Asphere-xyz/tacchain/x/oracle"
+	oraclekeeper "github.com/Asphere-xyz/tacchain/x/oracle/keeper"
+	"github.com/Asphere-xyz/tacchain/x/oracle/pricesource"
+	oracletypes "github.com/Asphere-xyz/tacchain/x/oracle/types"
+	"github.com/Asphere-xyz/tacchain/x/revenue"
+	revenuekeeper "github.com/Asphere-xyz/tacchain/x/revenue/keeper"
+	revenuetypes "github.com/Asphere-xyz/tacchain/x/revenue/types"
+	"github.com/Asphere-xyz/tacchain/x/smartaccount"
+	smartaccountkeeper "github.com/Asphere-xyz/tacchain/x/smartaccount/keeper"
+	smartaccounttypes "github.com/Asphere-xyz/tacchain/x/smartaccount/types"
+	"github.com/Asphere-xyz/tacchain/x/sponsor"
+	sponsorkeeper "github.com/Asphere-xyz/tacchain/x/sponsor/keeper"
+	sponsortypes "github.com/Asphere-xyz/tacchain/x/sponsor/types"
+
 	// Force-load the tracer engines to trigger registration due to Go-Ethereum v1.10.15 changes
 	_ "github.com/ethereum/go-ethereum/eth/tracers/js"
 	_ "github.com/ethereum/go-ethereum/eth/tracers/native"
@@ -172,6 +201,9 @@ var maccPerms = map[string][]string{
 	icatypes.ModuleName:         nil,
 	wasmtypes.ModuleName:        {authtypes.Burner},
 	evmtypes.ModuleName:         {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
+	feeabstypes.ModuleName:      nil,                                  // reserve paying the fees converted from other denoms
+	feeburntypes.ModuleName:     {authtypes.Burner},                   // burns the base fee of the txs
+	revenuetypes.ModuleName:     nil,                                  // holds the accrued revenues of the contracts
 }
 
 var (
@@ -239,6 +271,26 @@ type TacChainApp struct {
 	// Ethermint keepers
 	EvmKeeper       *evmkeeper.Keeper
 	FeeMarketKeeper feemarketkeeper.Keeper
+
+	OracleKeeper       oraclekeeper.Keeper
+	MsgFilterKeeper    msgfilterkeeper.Keeper
+	DeployerKeeper     deployerkeeper.Keeper
+	SponsorKeeper      sponsorkeeper.Keeper
+	FeeAbsKeeper       feeabskeeper.Keeper
+	BlocklistKeeper    blocklistkeeper.Keeper
+	FeeBurnKeeper      feeburnkeeper.Keeper
+	RevenueKeeper      revenuekeeper.Keeper
+	EvmCallKeeper      evmcallkeeper.Keeper
+	SmartAccountKeeper smartaccountkeeper.Keeper
+
+	// app-side mempool
+	mempool *TacMempool
+	// senders of Ethereum txs recovered in CheckTx
+	sigCache *SigCache
+	// loads the state of proposals ahead of block execution
+	prefetcher *Prefetcher
+	// start of the execution of the current block
+	blockStart time.Time
 }
 
 // NewTacChainApp returns a reference to an initialized TacChainApp.
@@ -253,57 +305,9 @@ func NewTacChainApp(
 	baseAppOptions ...func(*baseapp.BaseApp),
 ) *TacChainApp {
 	encodingConfig := MakeEncodingConfig()
-	txConfig := authtx.NewTxConfig(encodingConfig.Codec, authtx.DefaultSignModes)
+	txConfig := NewTxConfig(encodingConfig.Codec)
 
-	// Below we could construct and set an application specific mempool and
-	// ABCI 1.0 PrepareProposal and ProcessProposal handlers. These defaults are
-	// already set in the SDK's BaseApp, this shows an example of how to override
-	// them.
-	//
-	// Example:
-	//
-	// bApp := baseapp.NewBaseApp(...)
-	// nonceMempool := mempool.NewSenderNonceMempool()
-	// abciPropHandler := NewDefaultProposalHandler(nonceMempool, bApp)
-	//
-	// bApp.SetMempool(nonceMempool)
-	// bApp.SetPrepareProposal(abciPropHandler.PrepareProposalHandler())
-	// bApp.SetProcessProposal(abciPropHandler.ProcessProposalHandler())
-	//
-	// Alternatively, you can construct BaseApp options, append those to
-	// baseAppOptions and pass them to NewBaseApp.
-	//
-	// Example:
-	//
-	// prepareOpt = func(app *baseapp.BaseApp) {
-	// 	abciPropHandler := baseapp.NewDefaultProposalHandler(nonceMempool, app)
-	// 	app.SetPrepareProposal(abciPropHandler.PrepareProposalHandler())
-	// }
-	// baseAppOptions = append(baseAppOptions, prepareOpt)
-
-	// create and set dummy vote extension handler
-	// voteExtOp := func(bApp *baseapp.BaseApp) {
-	//	voteExtHandler := NewVoteExtensionHandler()
-	//	voteExtHandler.SetHandlers(bApp)
-	// }
-	// baseAppOptions = append(baseAppOptions, voteExtOp)
-
-	// create and set dummy vote extension handler
-	voteExtOp := func(bApp *baseapp.BaseApp) {
-		voteExtHandler := simapp.NewVoteExtensionHandler()
-		voteExtHandler.SetHandlers(bApp)
-	}
-	baseAppOptions = append(baseAppOptions, voteExtOp, baseapp.SetOptimisticExecution())
-
-	// NOTE we use custom transaction decoder that supports the sdk.Tx interface instead of sdk.StdTx
-	// Setup Mempool and Proposal Handlers
-	baseAppOptions = append(baseAppOptions, func(app *baseapp.BaseApp) {
-		mempool := mempool.NoOpMempool{}
-		app.SetMempool(mempool)
-		handler := baseapp.NewDefaultProposalHandler(mempool, app)
-		app.SetPrepareProposal(handler.PrepareProposalHandler())
-		app.SetProcessProposal(handler.ProcessProposalHandler())
-	})
+	baseAppOptions = append(baseAppOptions, baseapp.SetOptimisticExecution())
 
 	bApp := baseapp.NewBaseApp(AppName, logger, db, txConfig.TxDecoder(), baseAppOptions...)
 	bApp.SetCommitMultiStoreTracer(traceStore)
@@ -323,6 +327,10 @@ func NewTacChainApp(
 		icacontrollertypes.StoreKey,
 		// ethermint keys
 		evmtypes.StoreKey, feemarkettypes.StoreKey,
+		// tacchain keys
+		oracletypes.StoreKey, msgfiltertypes.StoreKey, deployertypes.StoreKey, sponsortypes.StoreKey,
+		feeabstypes.StoreKey, blocklisttypes.StoreKey, feeburntypes.StoreKey, revenuetypes.StoreKey,
+		smartaccounttypes.StoreKey,
 	)
 
 	tkeys := storetypes.NewTransientStoreKeys(paramstypes.TStoreKey, evmtypes.TransientKey, feemarkettypes.TransientKey)
@@ -479,6 +487,12 @@ func NewTacChainApp(
 		app.AccountKeeper,
 	)
 
+	app.MsgFilterKeeper = msgfilterkeeper.NewKeeper(
+		encodingConfig.Codec,
+		runtime.NewKVStoreService(keys[msgfiltertypes.StoreKey]),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	groupConfig := group.DefaultConfig()
 	/*
 		Example of setting group params:
@@ -488,7 +502,7 @@ func NewTacChainApp(
 		keys[group.StoreKey],
 		// runtime.NewKVStoreService(keys[group.StoreKey]),
 		encodingConfig.Codec,
-		app.MsgServiceRouter(),
+		msgfilterkeeper.NewMessageRouter(app.MsgFilterKeeper, app.MsgServiceRouter()), // reject disabled msgs of group proposals
 		app.AccountKeeper,
 		groupConfig,
 	)
@@ -593,6 +607,17 @@ func NewTacChainApp(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	app.BlocklistKeeper = blocklistkeeper.NewKeeper(
+		encodingConfig.Codec,
+		runtime.NewKVStoreService(keys[blocklisttypes.StoreKey]),
+		// the end blockers of these modules refund deposits, pay rewards and
+		// release unbonded funds, which must not fail
+		[]string{govtypes.ModuleName, distrtypes.ModuleName, stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName},
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	// reject the sends from and to blocked addresses
+	app.BankKeeper.AppendSendRestriction(app.BlocklistKeeper.SendRestriction)
+
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
 		encodingConfig.Codec,
 		keys[icahosttypes.StoreKey],
@@ -602,7 +627,7 @@ func NewTacChainApp(
 		app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
 		scopedICAHostKeeper,
-		app.MsgServiceRouter(),
+		msgfilterkeeper.NewMessageRouter(app.MsgFilterKeeper, app.MsgServiceRouter()), // reject disabled msgs of interchain accounts
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	// set grpc router for ica host
@@ -626,6 +651,14 @@ func NewTacChainApp(
 		panic(fmt.Sprintf("error while reading wasm config: %s", err))
 	}
 
+	tacConfig, err := ReadTacConfig(appOpts)
+	if err != nil {
+		panic(fmt.Sprintf("error while reading tac config: %s", err))
+	}
+
+	// expose the oracle prices to contracts
+	wasmOpts = append([]wasmkeeper.Option{oracleWasmQueryOption(app.GRPCQueryRouter(), encodingConfig.Codec)}, wasmOpts...)
+
 	// The last arguments can contain custom message handlers, and custom query handlers,
 	// if we want to allow any custom callbacks
 	app.WasmKeeper = wasmkeeper.NewKeeper(
@@ -681,6 +714,7 @@ func NewTacChainApp(
 	transferStack = transfer.NewIBCModule(app.TransferKeeper)
 	transferStack = ibccallbacks.NewIBCMiddleware(transferStack, app.IBCFeeKeeper, wasmStackIBCHandler, wasm.DefaultMaxIBCCallbackGas)
 	transferICS4Wrapper := transferStack.(porttypes.ICS4Wrapper)
+	transferStack = blocklist.NewIBCMiddleware(transferStack, app.BlocklistKeeper) // reject transfers from or to blocked addresses
 	transferStack = ibcfee.NewIBCMiddleware(transferStack, app.IBCFeeKeeper)
 	// Since the callbacks middleware itself is an ics4wrapper, it needs to be passed to the ica controller keeper
 	app.TransferKeeper.WithICS4Wrapper(transferICS4Wrapper)
@@ -705,9 +739,76 @@ func NewTacChainApp(
 	evmSs := app.GetSubspace(evmtypes.ModuleName)
 	app.EvmKeeper = evmkeeper.NewKeeper(
 		encodingConfig.Codec, runtime.NewKVStoreService(keys[evmtypes.StoreKey]), tkeys[evmtypes.TransientKey], authtypes.NewModuleAddress(govtypes.ModuleName),
-		app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.FeeMarketKeeper,
-		nil, geth.NewEVM, tracer, evmSs,
+		app.AccountKeeper, NewEthFeeGrantBankKeeper(app.BankKeeper), app.StakingKeeper, app.FeeMarketKeeper,
+		nil, blocklistkeeper.NewEVMConstructor(NewEthCircuitBreakerEVMConstructor(deployerkeeper.NewEVMConstructor(geth.NewEVM))), tracer, evmSs,
 	)
+	app.BlocklistKeeper.SetEVMKeeper(app.EvmKeeper)
+
+	app.DeployerKeeper = deployerkeeper.NewKeeper(
+		encodingConfig.Codec,
+		runtime.NewKVStoreService(keys[deployertypes.StoreKey]),
+		app.EvmKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.EvmCallKeeper = evmcallkeeper.NewKeeper(app.EvmKeeper, NewEthCallFilter(&app.CircuitKeeper, app.BlocklistKeeper))
+
+	app.SponsorKeeper = sponsorkeeper.NewKeeper(
+		encodingConfig.Codec,
+		runtime.NewKVStoreService(keys[sponsortypes.StoreKey]),
+		app.EvmKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.OracleKeeper = oraclekeeper.NewKeeper(
+		encodingConfig.Codec,
+		runtime.NewKVStoreService(keys[oracletypes.StoreKey]),
+		app.EvmKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.FeeAbsKeeper = feeabskeeper.NewKeeper(
+		encodingConfig.Codec,
+		runtime.NewKVStoreService(keys[feeabstypes.StoreKey]),
+		app.AccountKeeper,
+		app.OracleKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.FeeBurnKeeper = feeburnkeeper.NewKeeper(
+		encodingConfig.Codec,
+		runtime.NewKVStoreService(keys[feeburntypes.StoreKey]),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.DistrKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.RevenueKeeper = revenuekeeper.NewKeeper(
+		encodingConfig.Codec,
+		runtime.NewKVStoreService(keys[revenuetypes.StoreKey]),
+		app.AccountKeeper,
+		app.BankKeeper,
+		app.EvmKeeper,
+		&app.WasmKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.SmartAccountKeeper = smartaccountkeeper.NewKeeper(
+		encodingConfig.Codec,
+		runtime.NewKVStoreService(keys[smartaccounttypes.StoreKey]),
+		app.EvmKeeper,
+		&app.WasmKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	priceSource, err := pricesource.New(tacConfig.Oracle.PriceSource, tacConfig.Oracle.PriceSourceTimeout)
+	if err != nil {
+		panic(fmt.Sprintf("error while creating oracle price source: %s", err))
+	}
+	voteExtHandler := oraclekeeper.NewVoteExtensionHandler(app.OracleKeeper, priceSource)
+	app.SetExtendVoteHandler(voteExtHandler.ExtendVoteHandler())
+	app.SetVerifyVoteExtensionHandler(voteExtHandler.VerifyVoteExtensionHandler())
 
 	/****  Module Options ****/
 
@@ -740,7 +841,7 @@ func NewTacChainApp(
 		groupmodule.NewAppModule(encodingConfig.Codec, app.GroupKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		nftmodule.NewAppModule(encodingConfig.Codec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		consensus.NewAppModule(encodingConfig.Codec, app.ConsensusParamsKeeper),
-		circuit.NewAppModule(encodingConfig.Codec, app.CircuitKeeper),
+		newCircuitAppModule(encodingConfig.Codec, app.CircuitKeeper, app.EvmKeeper),
 		// non sdk modules
 		capability.NewAppModule(encodingConfig.Codec, *app.CapabilityKeeper, false),
 		wasm.NewAppModule(encodingConfig.Codec, &app.WasmKeeper, app.StakingKeeper, app.AccountKeeper, app.BankKeeper, app.MsgServiceRouter(), app.GetSubspace(wasmtypes.ModuleName)),
@@ -754,6 +855,17 @@ func NewTacChainApp(
 		// Ethermint app modules
 		feemarket.NewAppModule(app.FeeMarketKeeper, feeMarketSs),
 		evm.NewAppModule(app.EvmKeeper, app.AccountKeeper, evmSs),
+		// tacchain modules
+		oracle.NewAppModule(encodingConfig.Codec, app.OracleKeeper),
+		msgfilter.NewAppModule(encodingConfig.Codec, app.MsgFilterKeeper),
+		deployer.NewAppModule(encodingConfig.Codec, app.DeployerKeeper),
+		sponsor.NewAppModule(encodingConfig.Codec, app.SponsorKeeper),
+		feeabs.NewAppModule(encodingConfig.Codec, app.FeeAbsKeeper),
+		blocklist.NewAppModule(encodingConfig.Codec, app.BlocklistKeeper),
+		feeburn.NewAppModule(encodingConfig.Codec, app.FeeBurnKeeper),
+		revenue.NewAppModule(encodingConfig.Codec, app.RevenueKeeper),
+		evmcall.NewAppModule(encodingConfig.Codec, app.EvmCallKeeper),
+		smartaccount.NewAppModule(encodingConfig.Codec, app.SmartAccountKeeper),
 	)
 
 	// BasicModuleManager defines the module BasicManager is in charge of setting up basic,
@@ -866,7 +978,6 @@ func NewTacChainApp(
 		slashingtypes.ModuleName,
 		govtypes.ModuleName,
 		minttypes.ModuleName,
-		circuittypes.ModuleName,
 		// additional non simd modules
 		ibctransfertypes.ModuleName,
 		ibcexported.ModuleName,
@@ -879,6 +990,23 @@ func NewTacChainApp(
 		// NOTE: feemarket need to be initialized before genutil module:
 		// gentx transactions use MinGasPriceDecorator.AnteHandle
 		feemarkettypes.ModuleName,
+		// circuit writes the paused contracts into the evm state
+		circuittypes.ModuleName,
+		// oracle writes its price feed into the evm state
+		oracletypes.ModuleName,
+		// msgfilter is read by the ante handler of gentx transactions
+		msgfiltertypes.ModuleName,
+		// deployer writes its policy into the evm state
+		deployertypes.ModuleName,
+		sponsortypes.ModuleName,
+		feeabstypes.ModuleName,
+		// blocklist is read by the ante handler of gentx transactions
+		blocklisttypes.ModuleName,
+		// feeburn and revenue are read by the post handler of gentx transactions
+		feeburntypes.ModuleName,
+		revenuetypes.ModuleName,
+		// smartaccount is read by the ante handler of gentx transactions
+		smartaccounttypes.ModuleName,
 
 		genutiltypes.ModuleName,
 		evidencetypes.ModuleName,
@@ -911,6 +1039,7 @@ func NewTacChainApp(
 	// RegisterUpgradeHandlers is used for registering any on-chain upgrades.
 	// Make sure it's called after `app.ModuleManager` and `app.configurator` are set.
 	app.RegisterUpgradeHandlers()
+	app.RegisterForks()
 
 	autocliv1.RegisterQueryServer(app.GRPCQueryRouter(), runtimeservices.NewAutoCLIQueryService(app.ModuleManager.Modules))
 
@@ -944,7 +1073,10 @@ func NewTacChainApp(
 	app.SetPreBlocker(app.PreBlocker)
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
-	app.setAnteHandler(txConfig, cast.ToUint64(appOpts.Get(ethermintsrvflags.EVMMaxTxGasWanted)), wasmConfig, keys[wasmtypes.StoreKey])
+	app.sigCache = NewSigCache(tacConfig.SigCache.Size)
+	app.prefetcher = NewPrefetcher(tacConfig.Prefetch, app.TxDecode, app.EvmKeeper, app.sigCache, app.newPrefetchContext, logger)
+	app.setMempool(tacConfig)
+	app.setAnteHandler(txConfig, tacConfig, cast.ToUint64(appOpts.Get(ethermintsrvflags.EVMMaxTxGasWanted)), wasmConfig, keys[wasmtypes.StoreKey])
 
 	// must be before Loading version
 	// requires the snapshot store to be created and registered as a BaseAppOption
@@ -1007,57 +1139,33 @@ func NewTacChainApp(
 	return app
 }
 
-// fix for tacchain_2390-1 chain halt at height 3192450
-func (app *TacChainApp) fixValidatorsState(ctx sdk.Context) error {
-	validators, err := app.StakingKeeper.GetAllValidators(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to get all validators: %s", err)
-	}
-
-	for _, validator := range validators {
-		store := ctx.KVStore(app.GetKey(stakingtypes.StoreKey))
-
-		deleted := false
-
-		iterator := storetypes.KVStorePrefixIterator(store, stakingtypes.ValidatorsByPowerIndexKey)
-		defer iterator.Close()
-
-		for ; iterator.Valid(); iterator.Next() {
-			valAddr := stakingtypes.ParseValidatorPowerRankKey(iterator.Key())
-			val := sdk.ValAddress(valAddr).String()
-
-			// get operator addr
-			var operator sdk.ValAddress = nil
-			if validator.OperatorAddress != "" {
-				addr, err := sdk.ValAddressFromBech32(validator.OperatorAddress)
-				if err != nil {
-					return fmt.Errorf("failed to parse operator address: %s", err)
-				}
-				operator = addr
-			}
+// setMempool replaces the CometBFT FIFO ordering with the app-side TacMempool
+// and sets the proposal handlers that select txs from it.
+func (app *TacChainApp) setMempool(cfg TacConfig) {
+	app.mempool = NewTacMempool(cfg.Mempool, app.AccountKeeper, app.txConfig.TxEncoder())
+	app.SetMempool(app.mempool)
 
-			if bytes.Equal(valAddr, operator) {
-				if deleted {
-					fmt.Println("Duplicate validator address is: " + val)
-				} else {
-					deleted = true
-				}
-				store.Delete(iterator.Key())
-			}
-		}
+	handler := NewProposalHandler(app.mempool, app, app.EvmKeeper, app.DeployerKeeper, app.StakingKeeper, cfg.Lanes)
+	app.SetPrepareProposal(handler.PrepareProposalHandler())
+	app.SetProcessProposal(app.prefetcher.ProcessProposalHandler(handler.ProcessProposalHandler()))
+}
 
-		if err := app.StakingKeeper.SetValidatorByPowerIndex(ctx, validator); err != nil {
-			return fmt.Errorf("failed to set validator by power index: %s", err)
-		}
-		if _, err := app.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx); err != nil {
-			return fmt.Errorf("failed to apply and return validator set updates: %s", err)
-		}
+// newPrefetchContext returns a context reading the last committed state for
+// the Prefetcher.
+func (app *TacChainApp) newPrefetchContext() (sdk.Context, error) {
+	height := app.LastBlockHeight()
+	if height == 0 {
+		return sdk.Context{}, errors.New("no committed state")
 	}
 
-	return nil
+	cms, err := app.CommitMultiStore().CacheMultiStoreWithVersion(height)
+	if err != nil {
+		return sdk.Context{}, err
+	}
+	return sdk.NewContext(cms, tmproto.Header{Height: height}, false, app.Logger()), nil
 }
 
-func (app *TacChainApp) setAnteHandler(txConfig client.TxConfig, maxGasWanted uint64, wasmConfig wasmtypes.WasmConfig, txCounterStoreKey *storetypes.KVStoreKey) {
+func (app *TacChainApp) setAnteHandler(txConfig client.TxConfig, cfg TacConfig, maxGasWanted uint64, wasmConfig wasmtypes.WasmConfig, txCounterStoreKey *storetypes.KVStoreKey) {
 	anteHandler, err := NewAnteHandler(HandlerOptions{
 		HandlerOptions: authante.HandlerOptions{
 			AccountKeeper:          app.AccountKeeper,
@@ -1069,6 +1177,7 @@ func (app *TacChainApp) setAnteHandler(txConfig client.TxConfig, maxGasWanted ui
 			TxFeeChecker:           ethermintante.NewDynamicFeeChecker(app.EvmKeeper),
 		},
 		IBCKeeper:             app.IBCKeeper,
+		IBCPriorityBoost:      cfg.Lanes.IBCPriorityBoost,
 		WasmConfig:            &wasmConfig,
 		WasmKeeper:            &app.WasmKeeper,
 		TXCounterStoreService: runtime.NewKVStoreService(txCounterStoreKey),
@@ -1076,12 +1185,18 @@ func (app *TacChainApp) setAnteHandler(txConfig client.TxConfig, maxGasWanted ui
 		EvmKeeper:             app.EvmKeeper,
 		FeeMarketKeeper:       app.FeeMarketKeeper,
 		MaxTxGasWanted:        maxGasWanted,
-		DisabledAuthzMsgs: []string{
-			sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}),
-			sdk.MsgTypeURL(&vestingtypes.MsgCreateVestingAccount{}),
-			sdk.MsgTypeURL(&vestingtypes.MsgCreatePermanentLockedAccount{}),
-			sdk.MsgTypeURL(&vestingtypes.MsgCreatePeriodicVestingAccount{}),
-		},
+		Mempool:               app.mempool,
+		SigCache:              app.sigCache,
+		MsgFilter:             app.MsgFilterKeeper,
+		DeployerKeeper:        app.DeployerKeeper,
+		SponsorKeeper:         app.SponsorKeeper,
+		FeeAbsKeeper:          app.FeeAbsKeeper,
+		DistrKeeper:           app.DistrKeeper,
+		BlocklistKeeper:       app.BlocklistKeeper,
+		Codec:                 app.appCodec,
+		StakingKeeper:         app.StakingKeeper,
+		SmartAccountKeeper:    app.SmartAccountKeeper,
+		TxEncoder:             txConfig.TxEncoder(),
 	},
 	)
 	if err != nil {
@@ -1093,31 +1208,46 @@ func (app *TacChainApp) setAnteHandler(txConfig client.TxConfig, maxGasWanted ui
 }
 
 func (app *TacChainApp) setPostHandler() {
-	postHandler, err := posthandler.NewPostHandler(
-		posthandler.HandlerOptions{},
-	)
-	if err != nil {
-		panic(err)
-	}
-
-	app.SetPostHandler(postHandler)
+	app.SetPostHandler(sdk.ChainPostDecorators(
+		// pay the developer share of the fees to the called contracts
+		NewRevenueDecorator(app.RevenueKeeper, app.EvmKeeper, app.FeeAbsKeeper),
+		// burn the base fee of the txs and share the rest of their fees
+		NewFeeBurnDecorator(app.FeeBurnKeeper, app.EvmKeeper, app.FeeAbsKeeper),
+	))
 }
 
 // Name returns the name of the App
 func (app *TacChainApp) Name() string { return app.BaseApp.Name() }
 
 // PreBlocker application updates every pre block
-func (app *TacChainApp) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
-	return app.ModuleManager.PreBlock(ctx)
+func (app *TacChainApp) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	app.blockStart = time.Now()
+
+	res, err := app.ModuleManager.PreBlock(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the extended commit injected in PrepareProposal was validated in
+	// ProcessProposal already
+	if voteExtensionsInjected(ctx, req.Height) && len(req.Txs) > 0 {
+		var extCommit abci.ExtendedCommitInfo
+		if err := extCommit.Unmarshal(req.Txs[0]); err != nil {
+			app.Logger().Error("failed to decode extended commit", "height", req.Height, "err", err)
+			return res, nil
+		}
+		if err := app.OracleKeeper.AggregateVoteExtensions(ctx, extCommit); err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
 }
 
 // BeginBlocker application updates every begin block
 func (app *TacChainApp) BeginBlocker(ctx sdk.Context) (sdk.BeginBlock, error) {
-	// fix for tacchain_2390-1 chain halt at height 3192450
-	if app.ChainID() == DefaultChainID && app.LastBlockHeight() == 3192449 {
-		if err := app.fixValidatorsState(ctx); err != nil {
-			panic(fmt.Sprintf("failed to fix validators state: %s", err))
-		}
+	if err := upgrades.BeginForks(ctx, Forks, app.appKeepers()); err != nil {
+		panic(err)
 	}
 
 	return app.ModuleManager.BeginBlock(ctx)
@@ -1125,6 +1255,10 @@ func (app *TacChainApp) BeginBlocker(ctx sdk.Context) (sdk.BeginBlock, error) {
 
 // EndBlocker application updates every end block
 func (app *TacChainApp) EndBlocker(ctx sdk.Context) (sdk.EndBlock, error) {
+	// the prefetched state is of no use once the txs are executed
+	app.prefetcher.Stop()
+	blockExecutionMetric(app.blockStart, app.prefetcher != nil)
+
 	return app.ModuleManager.EndBlock(ctx)
 }
 
@@ -1262,6 +1396,9 @@ func (app *TacChainApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.A
 	// Register node gRPC service for grpc-gateway.
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register the upgrades query service for grpc-gateway.
+	upgrades.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
 	// Register grpc-gateway routes for all modules.
 	app.BasicModuleManager.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
@@ -1312,6 +1449,8 @@ func BlockedAddresses() map[string]bool {
 
 	// allow the following addresses to receive funds
 	delete(modAccAddrs, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	// the feeabs reserve is funded by community pool spends
+	delete(modAccAddrs, authtypes.NewModuleAddress(feeabstypes.ModuleName).String())
 
 	return modAccAddrs
 }
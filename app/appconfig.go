@@ -0,0 +1,306 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	flagMempoolMaxTxs            = "tac.mempool.max-txs"
+	flagMempoolMaxBytes          = "tac.mempool.max-bytes"
+	flagMempoolMaxTxsPerSender   = "tac.mempool.max-txs-per-sender"
+	flagMempoolMaxBytesPerSender = "tac.mempool.max-bytes-per-sender"
+	flagMempoolPriceBump         = "tac.mempool.price-bump"
+
+	flagLanesEVMShare    = "tac.lanes.evm-share"
+	flagLanesCosmosShare = "tac.lanes.cosmos-share"
+	flagLanesIBCShare    = "tac.lanes.ibc-share"
+	flagLanesIBCBoost    = "tac.lanes.ibc-priority-boost"
+
+	flagSigCacheSize = "tac.sig-cache.size"
+
+	flagPrefetchEnabled = "tac.prefetch.enabled"
+	flagPrefetchWorkers = "tac.prefetch.workers"
+
+	flagOraclePriceSource        = "tac.oracle.price-source"
+	flagOraclePriceSourceTimeout = "tac.oracle.price-source-timeout"
+)
+
+// TacConfig defines the node-local TacChain configuration read from the
+// [tac.*] sections of app.toml.
+type TacConfig struct {
+	Mempool  MempoolConfig  `mapstructure:"mempool"`
+	Lanes    LanesConfig    `mapstructure:"lanes"`
+	SigCache SigCacheConfig `mapstructure:"sig-cache"`
+	Prefetch PrefetchConfig `mapstructure:"prefetch"`
+	Oracle   OracleConfig   `mapstructure:"oracle"`
+}
+
+// MempoolConfig defines the configuration of the app-side mempool.
+type MempoolConfig struct {
+	// MaxTxs is the maximum number of transactions kept in the app-side mempool.
+	// Zero means unbounded.
+	MaxTxs int `mapstructure:"max-txs"`
+	// MaxBytes is the maximum total size of the transactions kept in the app-side
+	// mempool. Zero means unbounded.
+	MaxBytes int64 `mapstructure:"max-bytes"`
+	// MaxTxsPerSender is the maximum number of pending transactions of a single
+	// sender. Zero means unbounded.
+	MaxTxsPerSender int `mapstructure:"max-txs-per-sender"`
+	// MaxBytesPerSender is the maximum total size of the pending transactions of
+	// a single sender. Zero means unbounded.
+	MaxBytesPerSender int64 `mapstructure:"max-bytes-per-sender"`
+	// PriceBump is the minimum priority increase, in percent, a transaction must
+	// offer to replace a pending transaction with the same sender and nonce.
+	PriceBump uint64 `mapstructure:"price-bump"`
+}
+
+// LanesConfig defines the shares of the block gas, in percent, reserved for
+// each lane when preparing a proposal. Gas a lane does not use, as well as the
+// unreserved remainder, is filled with the transactions of the other lanes.
+type LanesConfig struct {
+	EVMShare    uint64 `mapstructure:"evm-share"`
+	CosmosShare uint64 `mapstructure:"cosmos-share"`
+	IBCShare    uint64 `mapstructure:"ibc-share"`
+	// IBCPriorityBoost raises the mempool priority of the txs of the IBC lane
+	// that relay a packet or advance a client, in percent.
+	IBCPriorityBoost uint64 `mapstructure:"ibc-priority-boost"`
+}
+
+// Validate checks that the lane shares do not exceed the block gas.
+func (c LanesConfig) Validate() error {
+	if total := c.EVMShare + c.CosmosShare + c.IBCShare; total > 100 {
+		return fmt.Errorf("lane shares must not exceed 100 percent in total, got %d", total)
+	}
+	return nil
+}
+
+// SigCacheConfig defines the cache of the Ethereum tx senders recovered in
+// CheckTx and reused in FinalizeBlock.
+type SigCacheConfig struct {
+	// Size is the maximum number of cached senders. Zero disables the cache.
+	Size int `mapstructure:"size"`
+}
+
+// PrefetchConfig defines the prefetching of the state read by the Ethereum txs
+// of a proposal while it's processed.
+type PrefetchConfig struct {
+	// Enabled starts prefetching when a proposal is received.
+	Enabled bool `mapstructure:"enabled"`
+	// Workers is the number of goroutines loading the state concurrently.
+	Workers int `mapstructure:"workers"`
+}
+
+// OracleConfig defines where a validator reads the prices it reports in its
+// vote extensions.
+type OracleConfig struct {
+	// PriceSource is the URI of the price source, either file:// or http(s)://.
+	// An empty source makes the node report no prices.
+	PriceSource string `mapstructure:"price-source"`
+	// PriceSourceTimeout bounds the time spent reading the price source while
+	// extending a vote.
+	PriceSourceTimeout time.Duration `mapstructure:"price-source-timeout"`
+}
+
+// DefaultTacConfig returns the default TacChain node configuration.
+func DefaultTacConfig() TacConfig {
+	return TacConfig{
+		Mempool: MempoolConfig{
+			MaxTxs:            5000,
+			MaxBytes:          128 << 20,
+			MaxTxsPerSender:   64,
+			MaxBytesPerSender: 4 << 20,
+			PriceBump:         10,
+		},
+		Lanes: LanesConfig{
+			EVMShare:    60,
+			CosmosShare: 25,
+			IBCShare:    15,
+
+			IBCPriorityBoost: 100,
+		},
+		SigCache: SigCacheConfig{
+			Size: 20000,
+		},
+		Prefetch: PrefetchConfig{
+			Enabled: true,
+			Workers: 4,
+		},
+		Oracle: OracleConfig{
+			PriceSourceTimeout: 500 * time.Millisecond,
+		},
+	}
+}
+
+// ReadTacConfig reads the TacChain node configuration from the app options,
+// falling back to defaults for values that are not set.
+func ReadTacConfig(opts servertypes.AppOptions) (TacConfig, error) {
+	cfg := DefaultTacConfig()
+	var err error
+	for flag, limit := range map[string]*int{
+		flagMempoolMaxTxs:          &cfg.Mempool.MaxTxs,
+		flagMempoolMaxTxsPerSender: &cfg.Mempool.MaxTxsPerSender,
+	} {
+		if v := opts.Get(flag); v != nil {
+			if *limit, err = cast.ToIntE(v); err != nil {
+				return cfg, err
+			}
+			if *limit < 0 {
+				return cfg, fmt.Errorf("%s must not be negative, got %d", flag, *limit)
+			}
+		}
+	}
+	for flag, limit := range map[string]*int64{
+		flagMempoolMaxBytes:          &cfg.Mempool.MaxBytes,
+		flagMempoolMaxBytesPerSender: &cfg.Mempool.MaxBytesPerSender,
+	} {
+		if v := opts.Get(flag); v != nil {
+			if *limit, err = cast.ToInt64E(v); err != nil {
+				return cfg, err
+			}
+			if *limit < 0 {
+				return cfg, fmt.Errorf("%s must not be negative, got %d", flag, *limit)
+			}
+		}
+	}
+	if v := opts.Get(flagMempoolPriceBump); v != nil {
+		if cfg.Mempool.PriceBump, err = cast.ToUint64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	for flag, share := range map[string]*uint64{
+		flagLanesEVMShare:    &cfg.Lanes.EVMShare,
+		flagLanesCosmosShare: &cfg.Lanes.CosmosShare,
+		flagLanesIBCShare:    &cfg.Lanes.IBCShare,
+		flagLanesIBCBoost:    &cfg.Lanes.IBCPriorityBoost,
+	} {
+		if v := opts.Get(flag); v != nil {
+			if *share, err = cast.ToUint64E(v); err != nil {
+				return cfg, err
+			}
+		}
+	}
+	if err := cfg.Lanes.Validate(); err != nil {
+		return cfg, err
+	}
+	if v := opts.Get(flagSigCacheSize); v != nil {
+		if cfg.SigCache.Size, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+		if cfg.SigCache.Size < 0 {
+			return cfg, fmt.Errorf("%s must not be negative, got %d", flagSigCacheSize, cfg.SigCache.Size)
+		}
+	}
+	if v := opts.Get(flagPrefetchEnabled); v != nil {
+		if cfg.Prefetch.Enabled, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagPrefetchWorkers); v != nil {
+		if cfg.Prefetch.Workers, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if cfg.Prefetch.Enabled && cfg.Prefetch.Workers < 1 {
+		return cfg, fmt.Errorf("%s must be positive, got %d", flagPrefetchWorkers, cfg.Prefetch.Workers)
+	}
+	if v := opts.Get(flagOraclePriceSource); v != nil {
+		if cfg.Oracle.PriceSource, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagOraclePriceSourceTimeout); v != nil {
+		if cfg.Oracle.PriceSourceTimeout, err = cast.ToDurationE(v); err != nil {
+			return cfg, err
+		}
+	}
+	return cfg, nil
+}
+
+// TacConfigTemplate is the app.toml template of the TacChain node configuration.
+const TacConfigTemplate = `
+###############################################################################
+###                             TAC Configuration                           ###
+###############################################################################
+
+[tac.mempool]
+
+# Maximum number of transactions kept in the app-side mempool. 0 means unbounded.
+max-txs = {{ .Tac.Mempool.MaxTxs }}
+
+# Maximum total size, in bytes, of the transactions kept in the app-side mempool.
+# 0 means unbounded.
+max-bytes = {{ .Tac.Mempool.MaxBytes }}
+
+# Once max-txs or max-bytes is reached, a new transaction evicts the pending
+# transaction with the lowest fee among the last transactions of every other
+# sender, or is rejected if it does not pay more.
+
+# Maximum number of pending transactions of a single sender. 0 means unbounded.
+max-txs-per-sender = {{ .Tac.Mempool.MaxTxsPerSender }}
+
+# Maximum total size, in bytes, of the pending transactions of a single sender.
+# 0 means unbounded.
+max-bytes-per-sender = {{ .Tac.Mempool.MaxBytesPerSender }}
+
+# Minimum priority increase, in percent, required to replace a pending
+# transaction with the same sender and nonce.
+price-bump = {{ .Tac.Mempool.PriceBump }}
+
+[tac.lanes]
+
+# Shares of the block max_gas, in percent, reserved for each lane when this node
+# prepares a proposal. Gas a lane leaves unused, as well as the remainder up to
+# 100 percent, is filled with the transactions of the other lanes.
+
+# Share of Ethereum transactions.
+evm-share = {{ .Tac.Lanes.EVMShare }}
+
+# Share of Cosmos SDK and CosmWasm transactions.
+cosmos-share = {{ .Tac.Lanes.CosmosShare }}
+
+# Share of transactions made only of IBC relayer messages, such as MsgRecvPacket
+# and MsgAcknowledgement.
+ibc-share = {{ .Tac.Lanes.IBCShare }}
+
+# Priority increase, in percent, of transactions of the IBC lane that relay a
+# packet or advance a client to a new height. Such transactions are proposed
+# ahead of, and evicted from the mempool after, other transactions paying the
+# same fee. 0 disables the boost.
+ibc-priority-boost = {{ .Tac.Lanes.IBCPriorityBoost }}
+
+[tac.sig-cache]
+
+# Maximum number of Ethereum tx senders recovered from signatures in CheckTx and
+# kept to skip the recovery when the txs are executed in a block. 0 disables the
+# cache.
+size = {{ .Tac.SigCache.Size }}
+
+[tac.prefetch]
+
+# Load the accounts, code and access-list storage slots read by the Ethereum
+# transactions of a proposal while it's processed, so that executing the block
+# finds them in the state caches.
+enabled = {{ .Tac.Prefetch.Enabled }}
+
+# Number of goroutines loading the state concurrently.
+workers = {{ .Tac.Prefetch.Workers }}
+
+[tac.oracle]
+
+# URI of the prices this validator reports in its vote extensions, either a
+# file:// path or an http(s):// endpoint serving a JSON object of pair to price,
+# e.g. {"TAC/USD": "0.0251"}. Leave empty to report no prices.
+price-source = "{{ .Tac.Oracle.PriceSource }}"
+
+# Maximum time spent reading the price source while extending a vote.
+price-source-timeout = "{{ .Tac.Oracle.PriceSourceTimeout }}"
+`
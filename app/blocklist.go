@@ -0,0 +1,170 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"context"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	protov2 "google.golang.org/protobuf/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	blocklisttypes "github.com/Asphere-xyz/tacchain/x/blocklist/types"
+	msgfilterkeeper "github.com/Asphere-xyz/tacchain/x/msgfilter/keeper"
+	msgfiltertypes "github.com/Asphere-xyz/tacchain/x/msgfilter/types"
+)
+
+var (
+	_ sdk.AnteDecorator = BlocklistDecorator{}
+	_ sdk.AnteDecorator = EthBlocklistDecorator{}
+)
+
+// BlocklistKeeper reports whether an address is blocked.
+type BlocklistKeeper interface {
+	IsBlocked(ctx context.Context, addr sdk.AccAddress) (bool, error)
+}
+
+// checkBlocked returns an error if any of the addresses is blocked.
+func checkBlocked(ctx context.Context, bk BlocklistKeeper, addrs ...sdk.AccAddress) error {
+	for _, addr := range addrs {
+		blocked, err := bk.IsBlocked(ctx, addr)
+		if err != nil {
+			return err
+		}
+		if blocked {
+			return errorsmod.Wrapf(blocklisttypes.ErrBlocked, "address %s", addr)
+		}
+	}
+	return nil
+}
+
+// MsgSignersGetter returns the signers of a message.
+type MsgSignersGetter interface {
+	GetMsgV1Signers(msg gogoproto.Message) ([][]byte, protov2.Message, error)
+}
+
+// BlocklistDecorator rejects the Cosmos txs signed by blocked addresses, or
+// whose fees are paid by one. The messages nested in the txs, such as the ones
+// of authz MsgExec, are rejected if signed by a blocked address too. The funds
+// sent to blocked addresses are rejected by the bank send restriction of the
+// blocklist module.
+type BlocklistDecorator struct {
+	blocklistKeeper BlocklistKeeper
+	signersGetter   MsgSignersGetter
+}
+
+// NewBlocklistDecorator creates a new BlocklistDecorator.
+func NewBlocklistDecorator(bk BlocklistKeeper, sg MsgSignersGetter) BlocklistDecorator {
+	return BlocklistDecorator{
+		blocklistKeeper: bk,
+		signersGetter:   sg,
+	}
+}
+
+// AnteHandle rejects the txs signed or paid by blocked addresses, or nesting
+// messages signed by blocked addresses.
+func (bd BlocklistDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sigTx, ok := tx.(signing.SigVerifiableTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(errortypes.ErrTxDecode, "invalid transaction type")
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+	addrs := make([]sdk.AccAddress, 0, len(signers)+1)
+	for _, signer := range signers {
+		addrs = append(addrs, signer)
+	}
+	if feeTx, ok := tx.(sdk.FeeTx); ok && feeTx.FeeGranter() != nil {
+		addrs = append(addrs, feeTx.FeeGranter())
+	}
+
+	if err := checkBlocked(ctx, bd.blocklistKeeper, addrs...); err != nil {
+		return ctx, err
+	}
+	if err := bd.checkNestedSigners(ctx, tx.GetMsgs(), 0); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+// checkNestedSigners returns an error if any of the messages nested in msgs is
+// signed by a blocked address.
+func (bd BlocklistDecorator) checkNestedSigners(ctx sdk.Context, msgs []sdk.Msg, depth int) error {
+	for _, msg := range msgs {
+		nested, err := msgfilterkeeper.NestedMsgs(msg)
+		if err != nil {
+			return err
+		}
+		if len(nested) == 0 {
+			continue
+		}
+		if depth >= msgfilterkeeper.MaxNestedMsgDepth {
+			return errorsmod.Wrapf(msgfiltertypes.ErrNestedMsgs, "more than %d levels", msgfilterkeeper.MaxNestedMsgDepth)
+		}
+
+		for _, nestedMsg := range nested {
+			signers, _, err := bd.signersGetter.GetMsgV1Signers(nestedMsg)
+			if err != nil {
+				return err
+			}
+			for _, signer := range signers {
+				if err := checkBlocked(ctx, bd.blocklistKeeper, signer); err != nil {
+					return err
+				}
+			}
+		}
+		if err := bd.checkNestedSigners(ctx, nested, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// EthBlocklistDecorator rejects the Ethereum txs sent by or to blocked
+// addresses. The value transferred from or to blocked addresses by the
+// contracts the txs call is rejected by the EVM, see
+// blocklistkeeper.NewEVMConstructor.
+type EthBlocklistDecorator struct {
+	blocklistKeeper BlocklistKeeper
+}
+
+// NewEthBlocklistDecorator creates a new EthBlocklistDecorator.
+func NewEthBlocklistDecorator(bk BlocklistKeeper) EthBlocklistDecorator {
+	return EthBlocklistDecorator{
+		blocklistKeeper: bk,
+	}
+}
+
+// AnteHandle rejects the txs sent by or to blocked addresses. It must run after
+// the sender of the txs is set.
+func (ebd EthBlocklistDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		addrs := []sdk.AccAddress{common.HexToAddress(msgEthTx.From).Bytes()}
+		if to := msgEthTx.AsTransaction().To(); to != nil {
+			addrs = append(addrs, to.Bytes())
+		}
+		if err := checkBlocked(ctx, ebd.blocklistKeeper, addrs...); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	blocklisttypes "github.com/Asphere-xyz/tacchain/x/blocklist/types"
+)
+
+// testBlocklistKeeper blocks the addresses it holds.
+type testBlocklistKeeper map[string]bool
+
+func (bk testBlocklistKeeper) IsBlocked(_ context.Context, addr sdk.AccAddress) (bool, error) {
+	return bk[addr.String()], nil
+}
+
+func TestBlocklistDecorator(t *testing.T) {
+	ctx := newTestMempoolContext(t)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	sender := sdk.AccAddress(testSender.Bytes())
+	cdc := MakeEncodingConfig().Codec
+
+	// the signer of newTestFeeAbsTx is testSender
+	tx := newTestFeeAbsTx(t, nil, 100_000)
+	_, err := NewBlocklistDecorator(testBlocklistKeeper{}, cdc).AnteHandle(ctx, tx, false, next)
+	require.NoError(t, err)
+
+	_, err = NewBlocklistDecorator(testBlocklistKeeper{sender.String(): true}, cdc).AnteHandle(ctx, tx, false, next)
+	require.ErrorIs(t, err, blocklisttypes.ErrBlocked)
+
+	builder := MakeEncodingConfig().TxConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(tx.GetMsgs()...))
+	builder.SetFeeGranter(testGranter)
+	_, err = NewBlocklistDecorator(testBlocklistKeeper{testGranter.String(): true}, cdc).AnteHandle(ctx, builder.GetTx(), false, next)
+	require.ErrorIs(t, err, blocklisttypes.ErrBlocked)
+
+	// the sender executes a send of a blocked granter, nested in another MsgExec
+	send := banktypes.NewMsgSend(testGranter, sender, sdk.NewCoins())
+	inner := authz.NewMsgExec(sender, []sdk.Msg{send})
+	outer := authz.NewMsgExec(sender, []sdk.Msg{&inner})
+	builder = MakeEncodingConfig().TxConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(&outer))
+	_, err = NewBlocklistDecorator(testBlocklistKeeper{}, cdc).AnteHandle(ctx, builder.GetTx(), false, next)
+	require.NoError(t, err)
+	_, err = NewBlocklistDecorator(testBlocklistKeeper{testGranter.String(): true}, cdc).AnteHandle(ctx, builder.GetTx(), false, next)
+	require.ErrorIs(t, err, blocklisttypes.ErrBlocked)
+}
+
+func TestEthBlocklistDecorator(t *testing.T) {
+	ctx := newTestMempoolContext(t)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	newTx := func(from common.Address, to *common.Address) sdk.Tx {
+		msg := evmtypes.NewTx(big.NewInt(2390), 0, to, big.NewInt(0), 100_000, big.NewInt(1), nil, nil, nil, nil)
+		tx, err := msg.BuildTx(MakeEncodingConfig().TxConfig.NewTxBuilder(), BaseDenom)
+		require.NoError(t, err)
+		msg.From = from.Hex()
+		return tx
+	}
+
+	blocked := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	decorator := NewEthBlocklistDecorator(testBlocklistKeeper{sdk.AccAddress(blocked.Bytes()).String(): true})
+
+	_, err := decorator.AnteHandle(ctx, newTx(testSender, &testContract), false, next)
+	require.NoError(t, err)
+	_, err = decorator.AnteHandle(ctx, newTx(testSender, nil), false, next)
+	require.NoError(t, err)
+
+	_, err = decorator.AnteHandle(ctx, newTx(blocked, &testContract), false, next)
+	require.ErrorIs(t, err, blocklisttypes.ErrBlocked)
+	_, err = decorator.AnteHandle(ctx, newTx(testSender, &blocked), false, next)
+	require.ErrorIs(t, err, blocklisttypes.ErrBlocked)
+}
+
+// testForwarderCode is the code of a contract forwarding the value it receives
+// to the address in its calldata. The code stops right after the call unless
+// checked, otherwise it checks the call succeeded.
+func testForwarderCode(checked bool) []byte {
+	code := []byte{
+		0x60, 0x00, // PUSH1 0x00, retSize
+		0x60, 0x00, // PUSH1 0x00, retOffset
+		0x60, 0x00, // PUSH1 0x00, argsSize
+		0x60, 0x00, // PUSH1 0x00, argsOffset
+		0x34,       // CALLVALUE
+		0x60, 0x00, // PUSH1 0x00
+		0x35, // CALLDATALOAD, the recipient
+		0x5a, // GAS
+		0xf1, // CALL
+	}
+	if !checked {
+		return append(code, 0x00) // STOP
+	}
+	return append(code,
+		0x60, 0x15, // PUSH1 0x15
+		0x57,             // JUMPI
+		0x60, 0x00, 0x80, // PUSH1 0x00 DUP1
+		0xfd, // REVERT
+		0x5b, // JUMPDEST
+		0x00, // STOP
+	)
+}
+
+func TestBlocklistEVMTransfer(t *testing.T) {
+	app := newTestEIP712App(t)
+	ctx := app.NewContext(false).WithBlockHeight(1)
+	// the EVM pays the block proposer
+	validators, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+	ctx = ctx.WithProposer(consAddr)
+
+	sender := common.HexToAddress("0x2000000000000000000000000000000000000001")
+	blocked := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	other := common.HexToAddress("0x2000000000000000000000000000000000000003")
+	forwarder := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	stoppingForwarder := common.HexToAddress("0x1000000000000000000000000000000000000002")
+
+	funded := statedb.NewEmptyAccount()
+	funded.Balance = uint256.NewInt(1_000_000)
+	require.NoError(t, app.EvmKeeper.SetAccount(ctx, sender, *funded))
+	for addr, code := range map[common.Address][]byte{forwarder: testForwarderCode(true), stoppingForwarder: testForwarderCode(false)} {
+		app.EvmKeeper.SetCode(ctx, crypto.Keccak256(code), code)
+		require.NoError(t, app.EvmKeeper.SetAccount(ctx, addr, statedb.Account{Balance: new(uint256.Int), CodeHash: crypto.Keccak256(code)}))
+	}
+	require.NoError(t, app.BlocklistKeeper.Block(ctx, blocked.Bytes()))
+
+	transfer := func(to, recipient common.Address) (*evmtypes.MsgEthereumTxResponse, error) {
+		return app.EvmKeeper.ApplyMessage(ctx, core.Message{
+			From:      sender,
+			To:        &to,
+			Value:     big.NewInt(100),
+			GasLimit:  100_000,
+			GasPrice:  new(big.Int),
+			GasFeeCap: new(big.Int),
+			GasTipCap: new(big.Int),
+			Data:      common.LeftPadBytes(recipient.Bytes(), 32),
+		}, nil, true)
+	}
+	denom := app.EvmKeeper.GetParams(ctx).EvmDenom
+	balance := func(addr common.Address) int64 {
+		return app.BankKeeper.GetBalance(ctx, addr.Bytes(), denom).Amount.Int64()
+	}
+
+	res, err := transfer(forwarder, other)
+	require.NoError(t, err)
+	require.False(t, res.Failed(), res.VmError)
+	require.Equal(t, int64(100), balance(other))
+
+	// the call forwarding the value to the blocked address fails
+	res, err = transfer(forwarder, blocked)
+	require.NoError(t, err)
+	require.Equal(t, vm.ErrOutOfGas.Error(), res.VmError)
+	require.Zero(t, balance(blocked))
+
+	// the transfers the EVM lets through are rejected when the EVM state is
+	// committed
+	_, err = transfer(stoppingForwarder, blocked)
+	require.ErrorIs(t, err, blocklisttypes.ErrBlocked)
+	_, err = transfer(blocked, blocked)
+	require.ErrorIs(t, err, blocklisttypes.ErrBlocked)
+	require.Zero(t, balance(blocked))
+
+	// the value is forwarded again once the address is unblocked
+	require.NoError(t, app.BlocklistKeeper.Unblock(ctx, blocked.Bytes()))
+	res, err = transfer(forwarder, blocked)
+	require.NoError(t, err)
+	require.False(t, res.Failed(), res.VmError)
+	require.Equal(t, int64(100), balance(blocked))
+}
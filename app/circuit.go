@@ -0,0 +1,334 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/params"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/circuit"
+	circuitante "cosmossdk.io/x/circuit/ante"
+	circuitkeeper "cosmossdk.io/x/circuit/keeper"
+	circuittypes "cosmossdk.io/x/circuit/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	evm "github.com/evmos/ethermint/x/evm/vm"
+)
+
+var (
+	_ sdk.AnteDecorator      = EthCircuitBreakerDecorator{}
+	_ circuittypes.MsgServer = ethCircuitMsgServer{}
+	_ module.HasServices     = circuitAppModule{}
+	_ module.HasGenesis      = circuitAppModule{}
+)
+
+// anyContract stands for every contract in the circuit breaker key of a
+// function selector.
+const anyContract = "*"
+
+// EthCircuitBreakerKey returns the key, tripped and reset like a message type
+// URL with the circuit module, that pauses Ethereum txs:
+//
+//   - with no contract, all Ethereum txs: /ethermint.evm.v1.MsgEthereumTx
+//   - with a contract, the calls to it: /ethermint.evm.v1.MsgEthereumTx/0x<contract>
+//   - with a contract and a selector, the calls of the function of the contract:
+//     /ethermint.evm.v1.MsgEthereumTx/0x<contract>/0x<selector>
+//
+// The contract can be "*" to pause a function selector of every contract. The
+// contract and selector are lower-case hex.
+func EthCircuitBreakerKey(contract string, selector []byte) string {
+	key := sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{})
+	if contract == "" {
+		return key
+	}
+	key += "/" + strings.ToLower(contract)
+	if len(selector) == 0 {
+		return key
+	}
+	return key + "/0x" + hex.EncodeToString(selector)
+}
+
+// EthCircuitBreakerDecorator checks the circuit breaker for the Ethereum txs,
+// which circuitante.CircuitBreakerDecorator cannot pause beyond all of them at
+// once. A tx is rejected if the breaker is tripped for all Ethereum txs, for
+// its recipient, or for the function selector it calls on its recipient or on
+// any contract. The calls of the paused contracts and functions made by
+// contracts are failed by the EVM, see NewEthCircuitBreakerEVMConstructor.
+type EthCircuitBreakerDecorator struct {
+	circuitKeeper circuitante.CircuitBreaker
+}
+
+// NewEthCircuitBreakerDecorator creates a new EthCircuitBreakerDecorator.
+func NewEthCircuitBreakerDecorator(ck circuitante.CircuitBreaker) EthCircuitBreakerDecorator {
+	return EthCircuitBreakerDecorator{
+		circuitKeeper: ck,
+	}
+}
+
+// AnteHandle rejects the Ethereum txs paused by the circuit breaker.
+func (ecbd EthCircuitBreakerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		ethTx := msgEthTx.AsTransaction()
+		if err := checkEthCircuitBreaker(ctx, ecbd.circuitKeeper, ethTx.To(), ethTx.Data()); err != nil {
+			return ctx, err
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// ethCircuitBreakerCallKeys returns the keys pausing the calls of data on
+// contract.
+func ethCircuitBreakerCallKeys(contract common.Address, data []byte) []string {
+	keys := []string{EthCircuitBreakerKey(contract.Hex(), nil)}
+	if len(data) >= 4 {
+		keys = append(keys,
+			EthCircuitBreakerKey(contract.Hex(), data[:4]),
+			EthCircuitBreakerKey(anyContract, data[:4]),
+		)
+	}
+	return keys
+}
+
+// checkEthCircuitBreaker returns an error if the breaker is tripped for all
+// Ethereum txs, for the called contract, or for the function selector of data
+// on the contract or on any contract. The contract is nil for a contract
+// creation.
+func checkEthCircuitBreaker(ctx sdk.Context, ck circuitante.CircuitBreaker, to *common.Address, data []byte) error {
+	keys := []string{EthCircuitBreakerKey("", nil)}
+	if to != nil {
+		keys = append(keys, ethCircuitBreakerCallKeys(*to, data)...)
+	}
+
+	for _, key := range keys {
+		allowed, err := ck.IsAllowed(ctx, key)
+		if err != nil {
+			return err
+		}
+		if !allowed {
+			return errorsmod.Wrapf(errortypes.ErrUnauthorized, "tx paused by the circuit breaker for %s", key)
+		}
+	}
+	return nil
+}
+
+// EthCircuitBreakerAddress is the address of the account holding the keys of
+// the paused contracts and functions in its storage, so that they can be read
+// while executing EVM txs. The slot of a key is its keccak256 hash.
+var EthCircuitBreakerAddress = common.HexToAddress("0x0000000000000000000000000000000000000903")
+
+var ethCircuitBreakerTripped = common.BigToHash(big.NewInt(1))
+
+// EthCircuitBreakerEVMKeeper defines the EVM state methods used to mirror the
+// paused contracts and functions into the EVM state.
+type EthCircuitBreakerEVMKeeper interface {
+	GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account
+	SetAccount(ctx sdk.Context, addr common.Address, account statedb.Account) error
+	SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte)
+}
+
+// setEthCircuitBreaker sets whether key is tripped in the EVM state, creating
+// the circuit breaker account first if needed. The keys other than the ones of
+// contracts and functions are left out.
+func setEthCircuitBreaker(ctx sdk.Context, ek EthCircuitBreakerEVMKeeper, key string, tripped bool) error {
+	if !strings.HasPrefix(key, EthCircuitBreakerKey("", nil)+"/") {
+		return nil
+	}
+
+	if ek.GetAccount(ctx, EthCircuitBreakerAddress) == nil {
+		if err := ek.SetAccount(ctx, EthCircuitBreakerAddress, *statedb.NewEmptyAccount()); err != nil {
+			return err
+		}
+	}
+
+	var value []byte
+	if tripped {
+		value = ethCircuitBreakerTripped.Bytes()
+	}
+	ek.SetState(ctx, EthCircuitBreakerAddress, crypto.Keccak256Hash([]byte(key)), value)
+	return nil
+}
+
+// ethCircuitMsgServer is the msg server of the circuit module, mirroring the
+// keys tripped and reset into the EVM state.
+type ethCircuitMsgServer struct {
+	circuittypes.MsgServer
+	evmKeeper EthCircuitBreakerEVMKeeper
+}
+
+// TripCircuitBreaker implements circuittypes.MsgServer.
+func (s ethCircuitMsgServer) TripCircuitBreaker(ctx context.Context, msg *circuittypes.MsgTripCircuitBreaker) (*circuittypes.MsgTripCircuitBreakerResponse, error) {
+	res, err := s.MsgServer.TripCircuitBreaker(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range msg.MsgTypeUrls {
+		if err := setEthCircuitBreaker(sdk.UnwrapSDKContext(ctx), s.evmKeeper, key, true); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// ResetCircuitBreaker implements circuittypes.MsgServer.
+func (s ethCircuitMsgServer) ResetCircuitBreaker(ctx context.Context, msg *circuittypes.MsgResetCircuitBreaker) (*circuittypes.MsgResetCircuitBreakerResponse, error) {
+	res, err := s.MsgServer.ResetCircuitBreaker(ctx, msg)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range msg.MsgTypeUrls {
+		if err := setEthCircuitBreaker(sdk.UnwrapSDKContext(ctx), s.evmKeeper, key, false); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// circuitAppModule is the circuit module, mirroring the paused contracts and
+// functions into the EVM state.
+type circuitAppModule struct {
+	circuit.AppModule
+
+	keeper    circuitkeeper.Keeper
+	evmKeeper EthCircuitBreakerEVMKeeper
+}
+
+// newCircuitAppModule creates a new circuitAppModule.
+func newCircuitAppModule(cdc codec.Codec, keeper circuitkeeper.Keeper, ek EthCircuitBreakerEVMKeeper) circuitAppModule {
+	return circuitAppModule{
+		AppModule: circuit.NewAppModule(cdc, keeper),
+		keeper:    keeper,
+		evmKeeper: ek,
+	}
+}
+
+// RegisterServices registers the services of the circuit module, with the msg
+// server mirroring the keys into the EVM state.
+func (am circuitAppModule) RegisterServices(cfg module.Configurator) {
+	circuittypes.RegisterMsgServer(cfg.MsgServer(), ethCircuitMsgServer{
+		MsgServer: circuitkeeper.NewMsgServerImpl(am.keeper),
+		evmKeeper: am.evmKeeper,
+	})
+	circuittypes.RegisterQueryServer(cfg.QueryServer(), circuitkeeper.NewQueryServer(am.keeper))
+}
+
+// InitGenesis initializes the circuit module and mirrors the keys tripped in
+// genesis into the EVM state.
+func (am circuitAppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) {
+	am.AppModule.InitGenesis(ctx, cdc, data)
+	err := am.keeper.DisableList.Walk(ctx, nil, func(key string) (bool, error) {
+		return false, setEthCircuitBreaker(ctx, am.evmKeeper, key, true)
+	})
+	if err != nil {
+		panic(err)
+	}
+}
+
+// NewEthCircuitBreakerEVMConstructor wraps an EVM constructor to fail the
+// calls of the contracts and functions paused by the circuit breaker at every
+// call depth, so that a paused contract can't be reached through another
+// contract. It applies to every EVM tx, whether it's an Ethereum tx or is
+// executed on behalf of a Cosmos tx, as well as to eth_call and gas
+// estimation.
+//
+// The calls are failed by a tracer wrapping the one of the EVM. Ethermint
+// always sets a tracer, so this doesn't add a code path to the interpreter.
+func NewEthCircuitBreakerEVMConstructor(next evm.Constructor) evm.Constructor {
+	return func(
+		blockCtx vm.BlockContext,
+		txCtx vm.TxContext,
+		stateDB vm.StateDB,
+		chainConfig *params.ChainConfig,
+		config vm.Config,
+		customPrecompiles evm.PrecompiledContracts,
+	) evm.EVM {
+		tracer := config.Tracer
+		if tracer == nil {
+			tracer = evmtypes.NewNoOpTracer()
+		}
+		config.Tracer = &circuitGuard{EVMLogger: tracer, stateDB: stateDB}
+		return next(blockCtx, txCtx, stateDB, chainConfig, config, customPrecompiles)
+	}
+}
+
+// circuitGuard is a tracer that makes the calls of the paused contracts and
+// functions fail, and forwards every call to the wrapped tracer. The code
+// called by DELEGATECALL and CALLCODE is checked too.
+//
+// A tracer can't return an error, so the guard takes the remaining gas of the
+// call at its first opcode instead, which fails it with out of gas and reverts
+// its state, the same way as any failed call. Code that stops at its first
+// opcode does nothing and is left alone.
+type circuitGuard struct {
+	vm.EVMLogger
+
+	stateDB vm.StateDB
+	// depth is the call depth of the last opcode
+	depth int
+	// paused is the call depth of the paused call being entered, until its
+	// first opcode, or zero
+	paused int
+}
+
+func (g *circuitGuard) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	g.depth, g.paused = 0, 0
+	if !create {
+		// the tx itself runs at depth 1
+		g.check(1, to, input)
+	}
+	g.EVMLogger.CaptureStart(env, from, to, create, input, gas, value)
+}
+
+func (g *circuitGuard) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	switch typ {
+	case vm.CALL, vm.CALLCODE, vm.DELEGATECALL, vm.STATICCALL:
+		g.check(g.depth+1, to, input)
+	}
+	g.EVMLogger.CaptureEnter(typ, from, to, input, gas, value)
+}
+
+func (g *circuitGuard) CaptureExit(output []byte, gasUsed uint64, err error) {
+	g.paused = 0
+	g.EVMLogger.CaptureExit(output, gasUsed, err)
+}
+
+func (g *circuitGuard) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	g.depth = depth
+	if g.paused != 0 && depth == g.paused {
+		scope.Contract.Gas = 0
+		g.paused = 0
+	}
+	g.EVMLogger.CaptureState(pc, op, gas, cost, scope, rData, depth, err)
+}
+
+// check fails the call at depth of data on contract if it's paused.
+func (g *circuitGuard) check(depth int, contract common.Address, data []byte) {
+	for _, key := range ethCircuitBreakerCallKeys(contract, data) {
+		if g.stateDB.GetState(EthCircuitBreakerAddress, crypto.Keccak256Hash([]byte(key))) == ethCircuitBreakerTripped {
+			g.paused = depth
+			return
+		}
+	}
+}
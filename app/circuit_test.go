@@ -0,0 +1,192 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	circuittypes "cosmossdk.io/x/circuit/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// testCircuitBreaker pauses the keys it holds.
+type testCircuitBreaker map[string]bool
+
+func (cb testCircuitBreaker) IsAllowed(_ context.Context, typeURL string) (bool, error) {
+	return !cb[typeURL], nil
+}
+
+func TestEthCircuitBreakerKey(t *testing.T) {
+	contract := "0xAbCd000000000000000000000000000000000001"
+	require.Equal(t, "/ethermint.evm.v1.MsgEthereumTx", EthCircuitBreakerKey("", nil))
+	require.Equal(t, "/ethermint.evm.v1.MsgEthereumTx/0xabcd000000000000000000000000000000000001", EthCircuitBreakerKey(contract, nil))
+	require.Equal(t, "/ethermint.evm.v1.MsgEthereumTx/0xabcd000000000000000000000000000000000001/0xa9059cbb", EthCircuitBreakerKey(contract, []byte{0xa9, 0x05, 0x9c, 0xbb}))
+	require.Equal(t, "/ethermint.evm.v1.MsgEthereumTx/*/0xa9059cbb", EthCircuitBreakerKey("*", []byte{0xa9, 0x05, 0x9c, 0xbb}))
+}
+
+func TestEthCircuitBreakerDecorator(t *testing.T) {
+	ctx := newTestMempoolContext(t)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	other := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	transfer := []byte{0xa9, 0x05, 0x9c, 0xbb}
+	approve := []byte{0x09, 0x5e, 0xa7, 0xb3}
+	newTx := func(to *common.Address, data []byte) sdk.Tx {
+		msg := evmtypes.NewTx(big.NewInt(2390), 0, to, big.NewInt(0), 50_000, big.NewInt(1), nil, nil, append(data, make([]byte, 64)...), nil)
+		tx, err := msg.BuildTx(MakeEncodingConfig().TxConfig.NewTxBuilder(), BaseDenom)
+		require.NoError(t, err)
+		return tx
+	}
+
+	testCases := []struct {
+		name    string
+		tripped []string
+		tx      sdk.Tx
+		paused  bool
+	}{
+		{
+			name: "nothing tripped",
+			tx:   newTx(&contract, transfer),
+		},
+		{
+			name:    "all ethereum txs",
+			tripped: []string{EthCircuitBreakerKey("", nil)},
+			tx:      newTx(nil, nil),
+			paused:  true,
+		},
+		{
+			name:    "paused contract",
+			tripped: []string{EthCircuitBreakerKey(contract.Hex(), nil)},
+			tx:      newTx(&contract, nil),
+			paused:  true,
+		},
+		{
+			name:    "other contract",
+			tripped: []string{EthCircuitBreakerKey(contract.Hex(), nil)},
+			tx:      newTx(&other, transfer),
+		},
+		{
+			name:    "paused function of the contract",
+			tripped: []string{EthCircuitBreakerKey(contract.Hex(), transfer)},
+			tx:      newTx(&contract, transfer),
+			paused:  true,
+		},
+		{
+			name:    "other function of the contract",
+			tripped: []string{EthCircuitBreakerKey(contract.Hex(), transfer)},
+			tx:      newTx(&contract, approve),
+		},
+		{
+			name:    "paused function of every contract",
+			tripped: []string{EthCircuitBreakerKey("*", transfer)},
+			tx:      newTx(&other, transfer),
+			paused:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cb := testCircuitBreaker{}
+			for _, key := range tc.tripped {
+				cb[key] = true
+			}
+
+			_, err := NewEthCircuitBreakerDecorator(cb).AnteHandle(ctx, tc.tx, false, next)
+			if tc.paused {
+				require.ErrorIs(t, err, errortypes.ErrUnauthorized)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestEthCircuitBreakerEVM(t *testing.T) {
+	app := newTestEIP712App(t)
+	ctx := app.NewContext(false).WithBlockHeight(1)
+	// the EVM pays the block proposer
+	validators, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+	ctx = ctx.WithProposer(consAddr)
+
+	sender := common.HexToAddress("0x2000000000000000000000000000000000000001")
+	target := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	wrapper := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	for addr, code := range map[common.Address][]byte{
+		// the target counts its calls
+		target: {
+			0x60, 0x00, // PUSH1 0x00
+			0x54,       // SLOAD
+			0x60, 0x01, // PUSH1 0x01
+			0x01,       // ADD
+			0x60, 0x00, // PUSH1 0x00
+			0x55, // SSTORE
+			0x00, // STOP
+		},
+		// the wrapper calls the contract in its calldata, and reverts if the
+		// call fails
+		wrapper: testForwarderCode(true),
+	} {
+		app.EvmKeeper.SetCode(ctx, crypto.Keccak256(code), code)
+		require.NoError(t, app.EvmKeeper.SetAccount(ctx, addr, statedb.Account{Balance: new(uint256.Int), CodeHash: crypto.Keccak256(code)}))
+	}
+
+	call := func(to common.Address, data []byte) *evmtypes.MsgEthereumTxResponse {
+		res, err := app.EvmKeeper.ApplyMessage(ctx, core.Message{
+			From:      sender,
+			To:        &to,
+			Value:     new(big.Int),
+			GasLimit:  100_000,
+			GasPrice:  new(big.Int),
+			GasFeeCap: new(big.Int),
+			GasTipCap: new(big.Int),
+			Data:      data,
+		}, nil, true)
+		require.NoError(t, err)
+		return res
+	}
+	calls := func() int64 {
+		return app.EvmKeeper.GetState(ctx, target, common.Hash{}).Big().Int64()
+	}
+	circuitMsg := func(msg sdk.Msg) {
+		_, err := app.MsgServiceRouter().Handler(msg)(ctx, msg)
+		require.NoError(t, err)
+	}
+	authority := authtypes.NewModuleAddress(govtypes.ModuleName).String()
+	key := EthCircuitBreakerKey(target.Hex(), nil)
+	throughWrapper := common.LeftPadBytes(target.Bytes(), 32)
+
+	require.False(t, call(wrapper, throughWrapper).Failed())
+	require.Equal(t, int64(1), calls())
+
+	// the paused target can't be called, directly or through the wrapper
+	circuitMsg(&circuittypes.MsgTripCircuitBreaker{Authority: authority, MsgTypeUrls: []string{key}})
+	require.Equal(t, vm.ErrOutOfGas.Error(), call(target, nil).VmError)
+	require.Equal(t, vm.ErrExecutionReverted.Error(), call(wrapper, throughWrapper).VmError)
+	require.Equal(t, int64(1), calls())
+
+	circuitMsg(&circuittypes.MsgResetCircuitBreaker{Authority: authority, MsgTypeUrls: []string{key}})
+	require.False(t, call(wrapper, throughWrapper).Failed())
+	require.Equal(t, int64(2), calls())
+}
@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ sdk.AnteDecorator = MinCommissionDecorator{}
+
+// MinCommissionKeeper returns the minimum commission rate of the validators,
+// the min_commission_rate governance parameter of x/staking.
+type MinCommissionKeeper interface {
+	MinCommissionRate(ctx context.Context) (sdkmath.LegacyDec, error)
+}
+
+// CheckMinCommission rejects the MsgCreateValidator and MsgEditValidator of
+// msgs, or nested in them by authz MsgExec and group MsgSubmitProposal, which
+// set a commission rate below the minimum, so that they don't enter the
+// mempool. x/staking enforces the minimum again when executing them. The
+// depth of the nested messages is bounded by the MsgFilterDecorator, which
+// runs first.
+func CheckMinCommission(ctx context.Context, k MinCommissionKeeper, msgs []sdk.Msg) error {
+	rates, err := commissionRates(msgs)
+	if err != nil || len(rates) == 0 {
+		return err
+	}
+
+	minRate, err := k.MinCommissionRate(ctx)
+	if err != nil {
+		return err
+	}
+	for _, rate := range rates {
+		if rate.LT(minRate) {
+			return errorsmod.Wrapf(errortypes.ErrInvalidRequest, "commission rate %s cannot be less than the min commission rate %s", rate, minRate)
+		}
+	}
+	return nil
+}
+
+// MinCommissionDecorator rejects the txs setting a validator commission rate
+// below the minimum, see CheckMinCommission. It runs after SetUpContext, so
+// that its reads of the minimum rate are charged to the tx.
+type MinCommissionDecorator struct {
+	minCommissionKeeper MinCommissionKeeper
+}
+
+// NewMinCommissionDecorator creates a new MinCommissionDecorator.
+func NewMinCommissionDecorator(k MinCommissionKeeper) MinCommissionDecorator {
+	return MinCommissionDecorator{
+		minCommissionKeeper: k,
+	}
+}
+
+// AnteHandle rejects the tx if any of its messages sets a commission rate below
+// the minimum.
+func (mcd MinCommissionDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := CheckMinCommission(ctx, mcd.minCommissionKeeper, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
+
+// commissionRates returns the commission rates set by the validator messages
+// of msgs, including the nested ones.
+func commissionRates(msgs []sdk.Msg) ([]sdkmath.LegacyDec, error) {
+	var rates []sdkmath.LegacyDec
+	for _, msg := range msgs {
+		var nested []sdk.Msg
+		var err error
+		switch msg := msg.(type) {
+		case *stakingtypes.MsgCreateValidator:
+			rates = append(rates, msg.Commission.Rate)
+		case *stakingtypes.MsgEditValidator:
+			if msg.CommissionRate != nil {
+				rates = append(rates, *msg.CommissionRate)
+			}
+		case *authz.MsgExec:
+			nested, err = msg.GetMessages()
+		case *group.MsgSubmitProposal:
+			nested, err = msg.GetMsgs()
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		nestedRates, err := commissionRates(nested)
+		if err != nil {
+			return nil, err
+		}
+		rates = append(rates, nestedRates...)
+	}
+	return rates, nil
+}
@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"context"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	v007 "github.com/Asphere-xyz/tacchain/app/upgrades/v0.0.7"
+)
+
+// testMinCommissionKeeper returns a fixed minimum commission rate.
+type testMinCommissionKeeper sdkmath.LegacyDec
+
+func (k testMinCommissionKeeper) MinCommissionRate(_ context.Context) (sdkmath.LegacyDec, error) {
+	return sdkmath.LegacyDec(k), nil
+}
+
+func TestMinCommissionDecorator(t *testing.T) {
+	ctx := newTestMempoolContext(t)
+	decorator := NewMinCommissionDecorator(testMinCommissionKeeper(sdkmath.LegacyNewDecWithPrec(5, 2)))
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	valAddr := sdk.ValAddress(testGranter).String()
+
+	newCreate := func(rate sdkmath.LegacyDec) sdk.Msg {
+		return &stakingtypes.MsgCreateValidator{
+			ValidatorAddress: valAddr,
+			Commission:       stakingtypes.NewCommissionRates(rate, sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec()),
+		}
+	}
+	newEdit := func(rate *sdkmath.LegacyDec) sdk.Msg {
+		return stakingtypes.NewMsgEditValidator(valAddr, stakingtypes.Description{}, rate, nil)
+	}
+	exec := func(msgs ...sdk.Msg) sdk.Msg {
+		msg := authz.NewMsgExec(testGranter, msgs)
+		return &msg
+	}
+	proposal := func(msgs ...sdk.Msg) sdk.Msg {
+		anys, err := sdktx.SetMsgs(msgs)
+		require.NoError(t, err)
+		return &group.MsgSubmitProposal{GroupPolicyAddress: testGranter.String(), Messages: anys}
+	}
+
+	low := sdkmath.LegacyNewDecWithPrec(1, 2)
+	high := sdkmath.LegacyNewDecWithPrec(10, 2)
+
+	testCases := []struct {
+		name string
+		msgs []sdk.Msg
+		ok   bool
+	}{
+		{"create above", []sdk.Msg{newCreate(high)}, true},
+		{"create at minimum", []sdk.Msg{newCreate(sdkmath.LegacyNewDecWithPrec(5, 2))}, true},
+		{"create below", []sdk.Msg{newCreate(low)}, false},
+		{"edit above", []sdk.Msg{newEdit(&high)}, true},
+		{"edit below", []sdk.Msg{newEdit(&low)}, false},
+		{"edit without rate", []sdk.Msg{newEdit(nil)}, true},
+		{"authz below", []sdk.Msg{exec(newEdit(&high), newEdit(&low))}, false},
+		{"group below", []sdk.Msg{proposal(exec(newCreate(low)))}, false},
+		{"group above", []sdk.Msg{proposal(newCreate(high))}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder := MakeEncodingConfig().TxConfig.NewTxBuilder()
+			require.NoError(t, builder.SetMsgs(tc.msgs...))
+			_, err := decorator.AnteHandle(ctx, builder.GetTx(), false, next)
+			if tc.ok {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, errortypes.ErrInvalidRequest)
+			}
+		})
+	}
+}
+
+func TestMinCommissionUpgrade(t *testing.T) {
+	app := NewTacChainAppWithCustomOptions(t, false, 0, SetupOptions{
+		Logger:  log.NewTestLogger(t),
+		DB:      dbm.NewMemDB(),
+		AppOpts: simtestutil.NewAppOptionsWithFlagHome(t.TempDir()),
+	})
+	ctx := app.NewContext(false)
+
+	// the genesis validator has no commission
+	validators, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.Len(t, validators, 1)
+	require.True(t, validators[0].Commission.Rate.IsZero())
+
+	handler := v007.CreateUpgradeHandler(app.ModuleManager, app.configurator, app.appKeepers())
+	vm := app.ModuleManager.GetVersionMap()
+
+	// without a rate in the plan info, the commissions are left unchanged
+	_, err = handler(ctx, upgradetypes.Plan{Name: v007.UpgradeName, Info: "https://example.com/binaries.json"}, vm)
+	require.NoError(t, err)
+	_, err = handler(ctx, upgradetypes.Plan{Name: v007.UpgradeName, Info: `{"binaries":{}}`}, vm)
+	require.NoError(t, err)
+	minRate, err := app.StakingKeeper.MinCommissionRate(ctx)
+	require.NoError(t, err)
+	require.True(t, minRate.IsZero())
+
+	_, err = handler(ctx, upgradetypes.Plan{Name: v007.UpgradeName, Info: `{"min_commission_rate":"1.5"}`}, vm)
+	require.Error(t, err)
+
+	rate := sdkmath.LegacyNewDecWithPrec(5, 2)
+	_, err = handler(ctx, upgradetypes.Plan{Name: v007.UpgradeName, Info: `{"min_commission_rate":"0.05"}`}, vm)
+	require.NoError(t, err)
+
+	minRate, err = app.StakingKeeper.MinCommissionRate(ctx)
+	require.NoError(t, err)
+	require.Equal(t, rate, minRate)
+
+	validators, err = app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	require.Equal(t, rate, validators[0].Commission.Rate)
+	require.True(t, validators[0].Commission.MaxRate.GTE(rate))
+}
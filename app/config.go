@@ -11,7 +11,6 @@ import (
 	simappparams "cosmossdk.io/simapp/params"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/migrations/legacytx"
-	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
 	"github.com/cosmos/gogoproto/proto"
 	evmv1 "github.com/evmos/ethermint/api/ethermint/evm/v1"
 	ethermintcmdcfg "github.com/evmos/ethermint/cmd/config"
@@ -116,7 +115,7 @@ func MakeEncodingConfig() simappparams.EncodingConfig {
 	encodingConfig := simappparams.EncodingConfig{
 		InterfaceRegistry: interfaceRegistry,
 		Codec:             appCodec,
-		TxConfig:          authtx.NewTxConfig(appCodec, authtx.DefaultSignModes),
+		TxConfig:          NewTxConfig(appCodec),
 		Amino:             legacyAmino,
 	}
 
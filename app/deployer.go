@@ -0,0 +1,94 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	deployertypes "github.com/Asphere-xyz/tacchain/x/deployer/types"
+)
+
+var _ sdk.AnteDecorator = EthDeployerDecorator{}
+
+// DeployerKeeper reports whether an address may deploy EVM contracts, and
+// whether an unprotected Ethereum tx is allowlisted.
+type DeployerKeeper interface {
+	UnprotectedTxKeeper
+	CanDeploy(ctx sdk.Context, addr common.Address) (bool, error)
+}
+
+// UnprotectedTxKeeper reports whether an unprotected (pre-EIP-155) Ethereum tx
+// is allowlisted by governance, by its hash or by its sender.
+type UnprotectedTxKeeper interface {
+	IsUnprotectedTxAllowed(ctx sdk.Context, hash common.Hash, sender common.Address) (bool, error)
+}
+
+// checkUnprotectedTx rejects ethTx if it's unprotected, unless the EVM params
+// allow unprotected txs or the tx is allowlisted. A nil keeper allowlists no tx.
+func checkUnprotectedTx(ctx sdk.Context, evmParams evmtypes.Params, k UnprotectedTxKeeper, ethTx *ethtypes.Transaction, sender common.Address) error {
+	if ethTx.Protected() || evmParams.GetAllowUnprotectedTxs() {
+		return nil
+	}
+	if k != nil {
+		allowed, err := k.IsUnprotectedTxAllowed(ctx, ethTx.Hash(), sender)
+		if err != nil {
+			return err
+		}
+		if allowed {
+			return nil
+		}
+	}
+	return errorsmod.Wrapf(
+		errortypes.ErrNotSupported,
+		"rejected unprotected Ethereum transaction. Please EIP155 sign your transaction to protect it against replay-attacks")
+}
+
+// EthDeployerDecorator rejects the contract creation txs of the senders not
+// allowed to deploy contracts, so that they don't enter the mempool. The
+// policy is enforced again while executing the txs, which also covers the
+// contracts created with CREATE and CREATE2, see deployerkeeper.NewEVMConstructor.
+type EthDeployerDecorator struct {
+	deployerKeeper DeployerKeeper
+}
+
+// NewEthDeployerDecorator creates a new EthDeployerDecorator.
+func NewEthDeployerDecorator(dk DeployerKeeper) EthDeployerDecorator {
+	return EthDeployerDecorator{
+		deployerKeeper: dk,
+	}
+}
+
+// AnteHandle rejects the contract creation txs of the senders not allowed to
+// deploy contracts. It must run after the sender of the txs is set.
+func (edd EthDeployerDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+		if msgEthTx.AsTransaction().To() != nil {
+			continue
+		}
+
+		sender := common.HexToAddress(msgEthTx.From)
+		allowed, err := edd.deployerKeeper.CanDeploy(ctx, sender)
+		if err != nil {
+			return ctx, err
+		}
+		if !allowed {
+			return ctx, errorsmod.Wrapf(deployertypes.ErrUnauthorized, "address %s", sender)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,69 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	deployertypes "github.com/Asphere-xyz/tacchain/x/deployer/types"
+)
+
+// testDeployerKeeper allows the addresses it holds to deploy contracts.
+type testDeployerKeeper map[common.Address]bool
+
+func (dk testDeployerKeeper) CanDeploy(_ sdk.Context, addr common.Address) (bool, error) {
+	return dk[addr], nil
+}
+
+func (testDeployerKeeper) IsUnprotectedTxAllowed(sdk.Context, common.Hash, common.Address) (bool, error) {
+	return false, nil
+}
+
+// testUnprotectedTxKeeper allowlists the unprotected txs with the hashes or the
+// senders it holds.
+type testUnprotectedTxKeeper struct {
+	hashes  map[common.Hash]bool
+	senders map[common.Address]bool
+}
+
+func (uk testUnprotectedTxKeeper) IsUnprotectedTxAllowed(_ sdk.Context, hash common.Hash, sender common.Address) (bool, error) {
+	return uk.hashes[hash] || uk.senders[sender], nil
+}
+
+func TestEthDeployerDecorator(t *testing.T) {
+	ctx := newTestMempoolContext(t)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	allowed := common.HexToAddress("0x2000000000000000000000000000000000000001")
+	other := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	newTx := func(from common.Address, to *common.Address) sdk.Tx {
+		msg := evmtypes.NewTx(big.NewInt(2390), 0, to, big.NewInt(0), 100_000, big.NewInt(1), nil, nil, []byte{0x60, 0x00}, nil)
+		tx, err := msg.BuildTx(MakeEncodingConfig().TxConfig.NewTxBuilder(), BaseDenom)
+		require.NoError(t, err)
+		msg.From = from.Hex()
+		return tx
+	}
+
+	decorator := NewEthDeployerDecorator(testDeployerKeeper{allowed: true})
+
+	_, err := decorator.AnteHandle(ctx, newTx(allowed, nil), false, next)
+	require.NoError(t, err)
+
+	_, err = decorator.AnteHandle(ctx, newTx(other, nil), false, next)
+	require.ErrorIs(t, err, deployertypes.ErrUnauthorized)
+
+	// calls are not restricted
+	_, err = decorator.AnteHandle(ctx, newTx(other, &contract), false, next)
+	require.NoError(t, err)
+}
@@ -0,0 +1,246 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	errorsmod "cosmossdk.io/errors"
+	txsigning "cosmossdk.io/x/tx/signing"
+	"cosmossdk.io/x/tx/signing/aminojson"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+
+	"github.com/evmos/ethermint/ethereum/eip712"
+)
+
+// SignModeEIP712 is the sign mode of the Cosmos txs signed as EIP-712 typed
+// data, e.g. with eth_signTypedData_v4 in MetaMask. The SDK doesn't define it,
+// so it's numbered after the EIP.
+const SignModeEIP712 = signingtypes.SignMode(712)
+
+var (
+	_ txsigning.SignModeHandler = EIP712SignModeHandler{}
+	_ sdk.AnteDecorator         = SigVerificationDecorator{}
+)
+
+// EIP712SignModeHandler implements SignModeEIP712, which replaces the legacy
+// EIP-712 txs carrying an ExtensionOptionsWeb3Tx. The typed data of a tx is
+// built from its legacy Amino JSON sign doc by ethermint's eip712 package:
+//
+//   - the primary type Tx has the account_number, chain_id, fee, memo and
+//     sequence fields of the sign doc, along with the timeout_height and the
+//     fee payer and granter if set;
+//   - the messages are the msg0, msg1... fields of Tx, holding the type and
+//     value of their Amino JSON. Their types are named after the Amino names
+//     of the messages, e.g. TypeMsgDelegate0 for cosmos-sdk/MsgDelegate, and
+//     the types of their objects after the paths of the objects, e.g.
+//     TypeValueAmount0 for the amount of the value;
+//   - the domain is named "Cosmos Web3", version "1.0.0", with the EIP-155
+//     chain ID of the chain.
+//
+// The sign bytes are the EIP-712 encoding of the typed data, whose Keccak-256
+// hash is what the eth_secp256k1 keys sign. The messages of a tx must have a
+// single signer, like for the legacy EIP-712 txs.
+type EIP712SignModeHandler struct {
+	aminoJSON *aminojson.SignModeHandler
+}
+
+// NewEIP712SignModeHandler creates a new EIP712SignModeHandler resolving the
+// messages with the files of fileResolver.
+func NewEIP712SignModeHandler(fileResolver txsigning.ProtoFileResolver) EIP712SignModeHandler {
+	return EIP712SignModeHandler{
+		aminoJSON: aminojson.NewSignModeHandler(aminojson.SignModeHandlerOptions{
+			FileResolver: fileResolver,
+		}),
+	}
+}
+
+// Mode implements txsigning.SignModeHandler.
+func (EIP712SignModeHandler) Mode() signingv1beta1.SignMode {
+	return signingv1beta1.SignMode(SignModeEIP712)
+}
+
+// GetSignBytes implements txsigning.SignModeHandler. It returns the EIP-712
+// encoding of the typed data of the tx, prefixed by "\x19\x01".
+func (h EIP712SignModeHandler) GetSignBytes(ctx context.Context, signerData txsigning.SignerData, txData txsigning.TxData) ([]byte, error) {
+	typedData, err := h.GetTypedData(ctx, signerData, txData)
+	if err != nil {
+		return nil, err
+	}
+
+	_, rawData, err := apitypes.TypedDataAndHash(typedData)
+	if err != nil {
+		return nil, fmt.Errorf("cannot encode EIP-712 typed data: %w", err)
+	}
+	return []byte(rawData), nil
+}
+
+// GetTypedData returns the EIP-712 typed data of the tx, as signed by the
+// wallets.
+func (h EIP712SignModeHandler) GetTypedData(ctx context.Context, signerData txsigning.SignerData, txData txsigning.TxData) (apitypes.TypedData, error) {
+	signDoc, err := h.aminoJSON.GetSignBytes(ctx, signerData, txData)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+
+	typedData, err := eip712.GetEIP712TypedDataForMsg(signDoc)
+	if err != nil {
+		return apitypes.TypedData{}, err
+	}
+	addOptionalTypes(typedData)
+	return typedData, nil
+}
+
+// addOptionalTypes adds the types of the optional fields of the sign doc that
+// ethermint leaves out of the typed data. The fields missing from the types
+// would be ignored by the EIP-712 encoding, so they wouldn't be signed.
+func addOptionalTypes(typedData apitypes.TypedData) {
+	if _, ok := typedData.Message["timeout_height"]; ok {
+		typedData.Types["Tx"] = append(typedData.Types["Tx"], apitypes.Type{Name: "timeout_height", Type: "string"})
+	}
+
+	fee, ok := typedData.Message["fee"].(map[string]interface{})
+	if !ok {
+		return
+	}
+	for _, field := range []string{"payer", "granter"} {
+		if _, ok := fee[field]; ok {
+			typedData.Types["Fee"] = append(typedData.Types["Fee"], apitypes.Type{Name: field, Type: "string"})
+		}
+	}
+}
+
+// NewTxConfig returns the tx config of the chain, which supports the default
+// sign modes of the SDK and SignModeEIP712.
+func NewTxConfig(cdc codec.Codec) client.TxConfig {
+	txConfig, err := NewTxConfigWithOptions(cdc, authtx.ConfigOptions{
+		EnabledSignModes: authtx.DefaultSignModes,
+	})
+	if err != nil {
+		panic(err)
+	}
+	return txConfig
+}
+
+// NewTxConfigWithOptions returns a tx config built with opts, which supports
+// SignModeEIP712 along with the sign modes of opts.
+func NewTxConfigWithOptions(cdc codec.Codec, opts authtx.ConfigOptions) (client.TxConfig, error) {
+	opts.CustomSignModes = append(opts.CustomSignModes, NewEIP712SignModeHandler(cdc.InterfaceRegistry()))
+	return authtx.NewTxConfigWithOptions(cdc, opts)
+}
+
+// SigVerificationDecorator verifies the signatures of the txs signed with
+// SignModeEIP712, which must have a single signer, and delegates the other
+// txs to the SDK SigVerificationDecorator, which cannot verify custom sign
+// modes.
+type SigVerificationDecorator struct {
+	accountKeeper   authante.AccountKeeper
+	signModeHandler *txsigning.HandlerMap
+	sigVerification authante.SigVerificationDecorator
+}
+
+// NewSigVerificationDecorator creates a new SigVerificationDecorator.
+func NewSigVerificationDecorator(ak authante.AccountKeeper, signModeHandler *txsigning.HandlerMap) SigVerificationDecorator {
+	return SigVerificationDecorator{
+		accountKeeper:   ak,
+		signModeHandler: signModeHandler,
+		sigVerification: authante.NewSigVerificationDecorator(ak, signModeHandler),
+	}
+}
+
+// AnteHandle verifies the signature of the txs signed with SignModeEIP712, like
+// the SDK SigVerificationDecorator does for the other sign modes.
+func (svd SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return ctx, errorsmod.Wrap(errortypes.ErrTxDecode, "invalid transaction type")
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+	if !isEIP712Signed(sigs) {
+		return svd.sigVerification.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+	if len(sigs) != 1 || len(signers) != 1 {
+		return ctx, errorsmod.Wrapf(errortypes.ErrUnauthorized, "EIP-712 signed txs must have a single signer, got %d signers and %d signatures", len(signers), len(sigs))
+	}
+
+	acc, err := authante.GetSignerAcc(ctx, svd.accountKeeper, signers[0])
+	if err != nil {
+		return ctx, err
+	}
+	pubKey := acc.GetPubKey()
+	if !simulate && pubKey == nil {
+		return ctx, errorsmod.Wrap(errortypes.ErrInvalidPubKey, "pubkey on account is not set")
+	}
+	if sigs[0].Sequence != acc.GetSequence() {
+		return ctx, errorsmod.Wrapf(errortypes.ErrWrongSequence, "account sequence mismatch, expected %d, got %d", acc.GetSequence(), sigs[0].Sequence)
+	}
+
+	// no need to verify signatures on recheck tx
+	if simulate || ctx.IsReCheckTx() || !ctx.IsSigverifyTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	var accNum uint64
+	if ctx.BlockHeight() > 0 {
+		accNum = acc.GetAccountNumber()
+	}
+	anyPk, err := codectypes.NewAnyWithValue(pubKey)
+	if err != nil {
+		return ctx, err
+	}
+	signerData := txsigning.SignerData{
+		Address:       acc.GetAddress().String(),
+		ChainID:       ctx.ChainID(),
+		AccountNumber: accNum,
+		Sequence:      acc.GetSequence(),
+		PubKey:        &anypb.Any{TypeUrl: anyPk.TypeUrl, Value: anyPk.Value},
+	}
+	adaptableTx, ok := tx.(authsigning.V2AdaptableTx)
+	if !ok {
+		return ctx, fmt.Errorf("expected tx to implement V2AdaptableTx, got %T", tx)
+	}
+
+	signBytes, err := svd.signModeHandler.GetSignBytes(ctx, signingv1beta1.SignMode(SignModeEIP712), signerData, adaptableTx.GetSigningTxData())
+	if err != nil {
+		return ctx, errorsmod.Wrap(errortypes.ErrUnauthorized, err.Error())
+	}
+	if !pubKey.VerifySignature(signBytes, sigs[0].Data.(*signingtypes.SingleSignatureData).Signature) {
+		return ctx, errorsmod.Wrapf(errortypes.ErrUnauthorized, "signature verification failed; please verify account number (%d), sequence (%d) and chain-id (%s)", accNum, acc.GetSequence(), ctx.ChainID())
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// isEIP712Signed reports whether any of the signatures uses SignModeEIP712.
+func isEIP712Signed(sigs []signingtypes.SignatureV2) bool {
+	for _, sig := range sigs {
+		if data, ok := sig.Data.(*signingtypes.SingleSignatureData); ok && data.SignMode == SignModeEIP712 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,316 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/stretchr/testify/require"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+
+	"github.com/evmos/ethermint/crypto/ethsecp256k1"
+)
+
+const testEIP712ChainID = "tacchain_2390-1"
+
+func newTestEIP712App(t *testing.T) *TacChainApp {
+	t.Helper()
+
+	return NewTacChainAppWithCustomOptions(t, false, 0, SetupOptions{
+		Logger:  log.NewNopLogger(),
+		DB:      dbm.NewMemDB(),
+		AppOpts: simtestutil.NewAppOptionsWithFlagHome(t.TempDir()),
+	})
+}
+
+// newTestEIP712Tx builds a tx of the msgs to sign with SignModeEIP712.
+func newTestEIP712Tx(t *testing.T, txConfig client.TxConfig, key *ethsecp256k1.PrivKey, sequence uint64, msgs ...sdk.Msg) client.TxBuilder {
+	t.Helper()
+
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(msgs...))
+	builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(BaseDenom, 1_000)))
+	builder.SetGasLimit(200_000)
+	builder.SetMemo("memo")
+	require.NoError(t, builder.SetSignatures(signingtypes.SignatureV2{
+		PubKey:   key.PubKey(),
+		Data:     &signingtypes.SingleSignatureData{SignMode: SignModeEIP712},
+		Sequence: sequence,
+	}))
+	return builder
+}
+
+// signTypedData signs the typed data of the tx like a wallet, with the
+// reference EIP-712 implementation of go-ethereum.
+func signTypedData(t *testing.T, handler EIP712SignModeHandler, key *ethsecp256k1.PrivKey, signerData txsigning.SignerData, tx sdk.Tx) []byte {
+	t.Helper()
+
+	typedData, err := handler.GetTypedData(sdk.Context{}, signerData, signingTxData(tx))
+	require.NoError(t, err)
+
+	// the wallets receive the typed data as JSON
+	bz, err := json.Marshal(typedData)
+	require.NoError(t, err)
+	var walletData apitypes.TypedData
+	require.NoError(t, json.Unmarshal(bz, &walletData))
+
+	hash, _, err := apitypes.TypedDataAndHash(walletData)
+	require.NoError(t, err)
+	ecdsaKey, err := key.ToECDSA()
+	require.NoError(t, err)
+	sig, err := crypto.Sign(hash, ecdsaKey)
+	require.NoError(t, err)
+	return sig
+}
+
+func signingTxData(tx sdk.Tx) txsigning.TxData {
+	return tx.(authsigning.V2AdaptableTx).GetSigningTxData()
+}
+
+func setTestEIP712Signature(t *testing.T, builder client.TxBuilder, key *ethsecp256k1.PrivKey, sequence uint64, sig []byte) {
+	t.Helper()
+
+	require.NoError(t, builder.SetSignatures(signingtypes.SignatureV2{
+		PubKey:   key.PubKey(),
+		Data:     &signingtypes.SingleSignatureData{SignMode: SignModeEIP712, Signature: sig},
+		Sequence: sequence,
+	}))
+}
+
+func TestEIP712SignMode(t *testing.T) {
+	app := newTestEIP712App(t)
+	handler := NewEIP712SignModeHandler(app.InterfaceRegistry())
+	require.Contains(t, app.TxConfig().SignModeHandler().SupportedModes(), signingv1beta1.SignMode(SignModeEIP712))
+
+	key, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := sdk.AccAddress(key.PubKey().Address())
+	coin := sdk.NewCoin(BaseDenom, sdkmath.NewInt(10))
+	delegate := stakingtypes.NewMsgDelegate(from.String(), sdk.ValAddress(from).String(), coin)
+	vote := govv1.NewMsgVote(from, 1, govv1.OptionYes, "")
+	transfer := transfertypes.NewMsgTransfer("transfer", "channel-0", coin, from.String(), "cosmos1receiver", clienttypes.NewHeight(1, 100), 0, "")
+
+	testCases := []struct {
+		name     string
+		msgs     []sdk.Msg
+		msgTypes []string
+		malleate func(client.TxBuilder)
+	}{
+		{"staking", []sdk.Msg{delegate}, []string{"TypeMsgDelegate0"}, nil},
+		{"governance", []sdk.Msg{vote}, []string{"TypeMsgVote0"}, nil},
+		{"ibc", []sdk.Msg{transfer}, []string{"TypeMsgTransfer0"}, nil},
+		{"several msgs", []sdk.Msg{delegate, vote}, []string{"TypeMsgDelegate0", "TypeMsgVote0"}, nil},
+		{"fee granter and timeout", []sdk.Msg{delegate}, []string{"TypeMsgDelegate0"}, func(builder client.TxBuilder) {
+			builder.SetFeeGranter(sdk.AccAddress("granter_____________"))
+			builder.SetTimeoutHeight(100)
+		}},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			builder := newTestEIP712Tx(t, app.TxConfig(), key, 3, tc.msgs...)
+			if tc.malleate != nil {
+				tc.malleate(builder)
+			}
+			signerData := txsigning.SignerData{Address: from.String(), ChainID: testEIP712ChainID, AccountNumber: 7, Sequence: 3}
+			txData := signingTxData(builder.GetTx())
+
+			typedData, err := handler.GetTypedData(sdk.Context{}, signerData, txData)
+			require.NoError(t, err)
+			require.Equal(t, "Tx", typedData.PrimaryType)
+			for i, msgType := range tc.msgTypes {
+				require.Contains(t, typedData.Types["Tx"], apitypes.Type{Name: fmt.Sprintf("msg%d", i), Type: msgType})
+			}
+
+			sig := signTypedData(t, handler, key, signerData, builder.GetTx())
+			signBytes, err := handler.GetSignBytes(sdk.Context{}, signerData, txData)
+			require.NoError(t, err)
+			require.True(t, key.PubKey().VerifySignature(signBytes, sig))
+
+			// the keyring signs the same digest as the wallets
+			keyringSig, err := key.Sign(signBytes)
+			require.NoError(t, err)
+			require.Equal(t, sig, keyringSig)
+
+			// every field of the tx is signed
+			builder.SetMemo("other memo")
+			if tc.malleate != nil {
+				builder.SetFeeGranter(sdk.AccAddress("other_granter_______"))
+			}
+			signBytes, err = handler.GetSignBytes(sdk.Context{}, signerData, signingTxData(builder.GetTx()))
+			require.NoError(t, err)
+			require.False(t, key.PubKey().VerifySignature(signBytes, sig))
+		})
+	}
+}
+
+// TestEIP712Vectors checks the typed data and the digest of the txs against
+// the vectors of testdata/eip712, whose digests and signatures were computed
+// by testdata/eip712/sign_typed_data.py, independently of go-ethereum.
+func TestEIP712Vectors(t *testing.T) {
+	app := newTestEIP712App(t)
+	handler := NewEIP712SignModeHandler(app.InterfaceRegistry())
+
+	keyBz, err := hex.DecodeString("4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318")
+	require.NoError(t, err)
+	key := &ethsecp256k1.PrivKey{Key: keyBz}
+	from := sdk.AccAddress(key.PubKey().Address())
+	require.Equal(t, "tac1936ndcmqtkwpdfar67ccnrjjjwt2vhprqvkxpe", from.String())
+	coin := sdk.NewCoin(BaseDenom, sdkmath.NewInt(10))
+
+	testCases := []struct {
+		file      string
+		msg       sdk.Msg
+		digest    string
+		signature string
+	}{
+		{
+			"delegate.json",
+			stakingtypes.NewMsgDelegate(from.String(), sdk.ValAddress(from).String(), coin),
+			"a3380fc85e48ee83f70c573def5267a003ce1e1677fec9be6c06bddcf81b9179",
+			"f303dd1831a7b90f7aa24306759b08371fd05ff0d3b9016e0c308cac1daad54f419297c6411c0fc40bca2dffc359a2e57bbdd67b701c9d610441e557abcd081e1b",
+		},
+		{
+			"vote.json",
+			govv1.NewMsgVote(from, 1, govv1.OptionYes, ""),
+			"48085c803a20cb6e06fd98bb7c286fee89b6243a2c7ca7e7c357ab4fabdbc0ea",
+			"dcea6fca79ac5dc2a327e0f93e4a5c04e641847c2f33f180ebd4921398d531e041b700a5b3ee97ef4e6acaf8feeefa1f76b42279ab2046a7fbd87c1eddb097721c",
+		},
+		{
+			"transfer.json",
+			transfertypes.NewMsgTransfer("transfer", "channel-0", coin, from.String(), "cosmos1receiver", clienttypes.NewHeight(1, 100), 0, ""),
+			"381f359546ba4116a3a93253188041eb6431dc632b92859cff5292904c43194d",
+			"2d85268b0641a7b4a6b0eeba5633f7f8a0d7bd66ea45bd13a82dc5c5dea07d891c940ca831e3079f008576319d45d675d78533faf8c077bfd67debe5b5a5138d1c",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.file, func(t *testing.T) {
+			expected, err := os.ReadFile(filepath.Join("testdata", "eip712", tc.file))
+			require.NoError(t, err)
+
+			builder := newTestEIP712Tx(t, app.TxConfig(), key, 3, tc.msg)
+			signerData := txsigning.SignerData{Address: from.String(), ChainID: testEIP712ChainID, AccountNumber: 7, Sequence: 3}
+			txData := signingTxData(builder.GetTx())
+
+			// the wallets receive the same typed data
+			typedData, err := handler.GetTypedData(sdk.Context{}, signerData, txData)
+			require.NoError(t, err)
+			bz, err := json.Marshal(typedData)
+			require.NoError(t, err)
+			require.JSONEq(t, string(expected), string(bz))
+
+			signBytes, err := handler.GetSignBytes(sdk.Context{}, signerData, txData)
+			require.NoError(t, err)
+			require.Equal(t, tc.digest, hex.EncodeToString(crypto.Keccak256(signBytes)))
+
+			// the wallet signature, with v = 27 or 28, is valid and the
+			// keyring signs the same r and s
+			walletSig, err := hex.DecodeString(tc.signature)
+			require.NoError(t, err)
+			require.True(t, key.PubKey().VerifySignature(signBytes, walletSig))
+			keyringSig, err := key.Sign(signBytes)
+			require.NoError(t, err)
+			require.Equal(t, walletSig[:64], keyringSig[:64])
+			require.Equal(t, walletSig[64]-27, keyringSig[64])
+		})
+	}
+}
+
+func TestSigVerificationDecorator(t *testing.T) {
+	app := newTestEIP712App(t)
+	ctx := app.NewContext(false).WithChainID(testEIP712ChainID).WithBlockHeight(1)
+	txConfig := app.TxConfig()
+	decorator := NewSigVerificationDecorator(app.AccountKeeper, txConfig.SignModeHandler())
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	key, err := ethsecp256k1.GenerateKey()
+	require.NoError(t, err)
+	from := sdk.AccAddress(key.PubKey().Address())
+	acc := app.AccountKeeper.NewAccountWithAddress(ctx, from)
+	require.NoError(t, acc.SetPubKey(key.PubKey()))
+	require.NoError(t, acc.SetSequence(3))
+	app.AccountKeeper.SetAccount(ctx, acc)
+
+	send := banktypes.NewMsgSend(from, sdk.AccAddress("recipient___________"), sdk.NewCoins(sdk.NewInt64Coin(BaseDenom, 1)))
+	signerData := txsigning.SignerData{Address: from.String(), ChainID: testEIP712ChainID, AccountNumber: acc.GetAccountNumber(), Sequence: 3}
+	handler := NewEIP712SignModeHandler(app.InterfaceRegistry())
+
+	// decode the txs like the nodes
+	decode := func(builder client.TxBuilder) sdk.Tx {
+		bz, err := txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		tx, err := txConfig.TxDecoder()(bz)
+		require.NoError(t, err)
+		return tx
+	}
+
+	builder := newTestEIP712Tx(t, txConfig, key, 3, send)
+	sig := signTypedData(t, handler, key, signerData, builder.GetTx())
+	setTestEIP712Signature(t, builder, key, 3, sig)
+	_, err = decorator.AnteHandle(ctx, decode(builder), false, next)
+	require.NoError(t, err)
+
+	// the signature doesn't cover another memo
+	builder.SetMemo("other memo")
+	_, err = decorator.AnteHandle(ctx, decode(builder), false, next)
+	require.ErrorIs(t, err, errortypes.ErrUnauthorized)
+
+	builder = newTestEIP712Tx(t, txConfig, key, 2, send)
+	setTestEIP712Signature(t, builder, key, 2, signTypedData(t, handler, key, signerData, builder.GetTx()))
+	_, err = decorator.AnteHandle(ctx, decode(builder), false, next)
+	require.ErrorIs(t, err, errortypes.ErrWrongSequence)
+
+	// the txs of several signers cannot be signed as typed data
+	other := banktypes.NewMsgSend(sdk.AccAddress("other_sender________"), from, sdk.NewCoins(sdk.NewInt64Coin(BaseDenom, 1)))
+	builder = newTestEIP712Tx(t, txConfig, key, 3, send, other)
+	_, err = decorator.AnteHandle(ctx, decode(builder), false, next)
+	require.ErrorIs(t, err, errortypes.ErrUnauthorized)
+
+	// the other sign modes are verified by the SDK
+	builder = newTestEIP712Tx(t, txConfig, key, 3, send)
+	directSigner := authsigning.SignerData{Address: from.String(), ChainID: testEIP712ChainID, AccountNumber: acc.GetAccountNumber(), Sequence: 3, PubKey: key.PubKey()}
+	require.NoError(t, builder.SetSignatures(signingtypes.SignatureV2{
+		PubKey:   key.PubKey(),
+		Data:     &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT},
+		Sequence: 3,
+	}))
+	signBytes, err := authsigning.GetSignBytesAdapter(ctx, txConfig.SignModeHandler(), signingtypes.SignMode_SIGN_MODE_DIRECT, directSigner, builder.GetTx())
+	require.NoError(t, err)
+	sig, err = key.Sign(signBytes)
+	require.NoError(t, err)
+	require.NoError(t, builder.SetSignatures(signingtypes.SignatureV2{
+		PubKey:   key.PubKey(),
+		Data:     &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT, Signature: sig},
+		Sequence: 3,
+	}))
+	_, err = decorator.AnteHandle(ctx, decode(builder), false, next)
+	require.NoError(t, err)
+}
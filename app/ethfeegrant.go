@@ -0,0 +1,260 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	ethermintante "github.com/evmos/ethermint/app/ante"
+	evmkeeper "github.com/evmos/ethermint/x/evm/keeper"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+var (
+	_ sdk.AnteDecorator = EthFeeGrantDecorator{}
+	_ sdk.AnteDecorator = EthAccountVerificationDecorator{}
+)
+
+// SponsorKeeper returns the granter sponsoring a contract, if any.
+type SponsorKeeper interface {
+	GetSponsor(ctx context.Context, contract common.Address) (sdk.AccAddress, error)
+}
+
+// EthFeeGrantKeeper defines the fee grant methods used to pay the fees of
+// Ethereum txs from the allowances of their sponsor.
+type EthFeeGrantKeeper interface {
+	GetAllowance(ctx context.Context, granter, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error)
+	UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error
+}
+
+// ethFeeGrantKey is the context key of the fee grant of an Ethereum tx.
+type ethFeeGrantKey struct{}
+
+// ethFeeGrant is an allowance the fees of an Ethereum tx may be paid from, set
+// in the context by the EthFeeGrantDecorator.
+type ethFeeGrant struct {
+	granter sdk.AccAddress
+	sender  common.Address
+	msg     sdk.Msg
+	// used reports whether the fees were paid by the granter, which is then
+	// refunded the leftover gas instead of the sender
+	used bool
+}
+
+// ethFeeGrantFromContext returns the fee grant of the Ethereum tx of sender
+// set in the context, if any.
+func ethFeeGrantFromContext(ctx context.Context, sender common.Address) *ethFeeGrant {
+	grant, ok := ctx.Value(ethFeeGrantKey{}).(*ethFeeGrant)
+	if !ok || grant.sender != sender {
+		return nil
+	}
+	return grant
+}
+
+// EthFeeGrantDecorator lets the sponsor of a contract pay the fees of the
+// Ethereum txs calling it, from the fee allowances it granted to their senders
+// with x/feegrant. Any allowance type can be used, e.g. an AllowedMsgAllowance
+// of /ethermint.evm.v1.MsgEthereumTx restricts the allowance to the Ethereum
+// txs calling the contracts the granter sponsors.
+//
+// The decorator only finds the allowance: the fees are paid from it by the
+// EthGasConsumeDecorator, the sender paying them if the allowance doesn't
+// accept them. Only txs with a single Ethereum tx, which is what JSON-RPC
+// submits, are sponsored.
+type EthFeeGrantDecorator struct {
+	sponsorKeeper  SponsorKeeper
+	feegrantKeeper EthFeeGrantKeeper
+}
+
+// NewEthFeeGrantDecorator creates a new EthFeeGrantDecorator.
+func NewEthFeeGrantDecorator(sk SponsorKeeper, fk EthFeeGrantKeeper) EthFeeGrantDecorator {
+	return EthFeeGrantDecorator{
+		sponsorKeeper:  sk,
+		feegrantKeeper: fk,
+	}
+}
+
+// AnteHandle sets the fee grant of the tx in the context if the contract it
+// calls is sponsored and the sponsor granted an allowance to the sender. It
+// must run after the sender of the tx is set.
+func (efgd EthFeeGrantDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return next(ctx, tx, simulate)
+	}
+	msgEthTx, ok := msgs[0].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msgs[0], (*evmtypes.MsgEthereumTx)(nil))
+	}
+	to := msgEthTx.AsTransaction().To()
+	if to == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	granter, err := efgd.sponsorKeeper.GetSponsor(ctx, *to)
+	if err != nil {
+		return ctx, err
+	}
+	sender := common.HexToAddress(msgEthTx.From)
+	if granter == nil || bytes.Equal(granter, sender.Bytes()) {
+		return next(ctx, tx, simulate)
+	}
+	if allowance, err := efgd.feegrantKeeper.GetAllowance(ctx, granter, sender.Bytes()); err != nil || allowance == nil {
+		return next(ctx, tx, simulate)
+	}
+
+	grant := &ethFeeGrant{granter: granter, sender: sender, msg: msgEthTx}
+	return next(ctx.WithValue(ethFeeGrantKey{}, grant), tx, simulate)
+}
+
+// EthAccountVerificationDecorator replaces ethermint's
+// EthAccountVerificationDecorator. It runs the same checks, except that the
+// senders of sponsored txs only need a balance covering the value of the tx.
+type EthAccountVerificationDecorator struct {
+	ak        evmtypes.AccountKeeper
+	evmKeeper ethermintante.EVMKeeper
+}
+
+// NewEthAccountVerificationDecorator creates a new
+// EthAccountVerificationDecorator.
+func NewEthAccountVerificationDecorator(ak evmtypes.AccountKeeper, ek ethermintante.EVMKeeper) EthAccountVerificationDecorator {
+	return EthAccountVerificationDecorator{
+		ak:        ak,
+		evmKeeper: ek,
+	}
+}
+
+// AnteHandle checks that the senders are EOAs, creating their account if
+// needed, and that they can pay for the txs. It only runs in CheckTx.
+func (avd EthAccountVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !ctx.IsCheckTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	for i, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
+		if err != nil {
+			return ctx, errorsmod.Wrapf(err, "failed to unpack tx data any for tx %d", i)
+		}
+
+		from := msgEthTx.GetFrom()
+		if from.Empty() {
+			return ctx, errorsmod.Wrap(errortypes.ErrInvalidAddress, "from address cannot be empty")
+		}
+
+		fromAddr := common.BytesToAddress(from)
+		acct := avd.evmKeeper.GetAccount(ctx, fromAddr)
+		if acct == nil {
+			acc := avd.ak.NewAccountWithAddress(ctx, from)
+			avd.ak.SetAccount(ctx, acc)
+			acct = statedb.NewEmptyAccount()
+		} else if acct.IsContract() {
+			return ctx, errorsmod.Wrapf(errortypes.ErrInvalidType,
+				"the sender is not EOA: address %s, codeHash <%s>", fromAddr, acct.CodeHash)
+		}
+
+		if ethFeeGrantFromContext(ctx, fromAddr) != nil {
+			if acct.Balance.ToBig().Cmp(txData.GetValue()) < 0 {
+				return ctx, errorsmod.Wrapf(errortypes.ErrInsufficientFunds,
+					"sender balance < tx value (%s < %s)", acct.Balance, txData.GetValue())
+			}
+			continue
+		}
+		if err := evmkeeper.CheckSenderBalance(sdkmath.NewIntFromBigInt(acct.Balance.ToBig()), txData); err != nil {
+			return ctx, errorsmod.Wrap(err, "failed to check sender balance")
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// ethFeeGrantEVMKeeper pays the fees of the Ethereum txs with a fee grant from
+// the allowance of the granter. It's used by ethermint's EthGasConsumeDecorator
+// to deduct the fees.
+type ethFeeGrantEVMKeeper struct {
+	ethermintante.EVMKeeper
+
+	accountKeeper  authante.AccountKeeper
+	bankKeeper     authtypes.BankKeeper
+	feegrantKeeper EthFeeGrantKeeper
+}
+
+// DeductTxCostsFromUserBalance deducts the fees of a tx from the balance of the
+// granter of its fee grant, if its allowance accepts them, and from the
+// balance of the sender otherwise.
+func (k ethFeeGrantEVMKeeper) DeductTxCostsFromUserBalance(ctx sdk.Context, fees sdk.Coins, from common.Address) error {
+	if grant := ethFeeGrantFromContext(ctx, from); grant != nil && !grant.used {
+		cacheCtx, write := ctx.CacheContext()
+		if err := k.deductGrantedFees(cacheCtx, grant, fees); err == nil {
+			write()
+			grant.used = true
+			return nil
+		}
+	}
+	return k.EVMKeeper.DeductTxCostsFromUserBalance(ctx, fees, from)
+}
+
+// deductGrantedFees uses the allowance of the granter to deduct the fees from
+// its balance.
+func (k ethFeeGrantEVMKeeper) deductGrantedFees(ctx sdk.Context, grant *ethFeeGrant, fees sdk.Coins) error {
+	grantee := sdk.AccAddress(grant.sender.Bytes())
+	if err := k.feegrantKeeper.UseGrantedFees(ctx, grant.granter, grantee, fees, []sdk.Msg{grant.msg}); err != nil {
+		return err
+	}
+
+	granterAcc, err := authante.GetSignerAcc(ctx, k.accountKeeper, grant.granter)
+	if err != nil {
+		return err
+	}
+	if err := authante.DeductFees(k.bankKeeper, ctx, granterAcc, fees); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeTx,
+		sdk.NewAttribute(sdk.AttributeKeyFeePayer, grant.granter.String()),
+	))
+	return nil
+}
+
+// EthFeeGrantBankKeeper is the bank keeper of the EVM keeper. It refunds the
+// leftover gas of the Ethereum txs whose fees were paid from a fee grant to
+// the granter, instead of the sender.
+type EthFeeGrantBankKeeper struct {
+	evmtypes.BankKeeper
+}
+
+// NewEthFeeGrantBankKeeper creates a new EthFeeGrantBankKeeper.
+func NewEthFeeGrantBankKeeper(bk evmtypes.BankKeeper) EthFeeGrantBankKeeper {
+	return EthFeeGrantBankKeeper{BankKeeper: bk}
+}
+
+// SendCoinsFromModuleToAccount sends the refunds of leftover gas, which the EVM
+// keeper pays from the fee collector, to the granter paying the fees of the tx.
+func (bk EthFeeGrantBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if senderModule == authtypes.FeeCollectorName {
+		if grant := ethFeeGrantFromContext(ctx, common.BytesToAddress(recipientAddr)); grant != nil && grant.used {
+			recipientAddr = grant.granter
+		}
+	}
+	return bk.BankKeeper.SendCoinsFromModuleToAccount(ctx, senderModule, recipientAddr, amt)
+}
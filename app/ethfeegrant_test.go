@@ -0,0 +1,205 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/x/feegrant"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	ethermintante "github.com/evmos/ethermint/app/ante"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// testSponsorKeeper holds the sponsors of contracts.
+type testSponsorKeeper map[common.Address]sdk.AccAddress
+
+func (sk testSponsorKeeper) GetSponsor(_ context.Context, contract common.Address) (sdk.AccAddress, error) {
+	return sk[contract], nil
+}
+
+// testFeeGrantKeeper holds allowances by grantee, all from the same granter,
+// and rejects every fee if reject is set.
+type testFeeGrantKeeper struct {
+	grantees map[string]bool
+	reject   bool
+}
+
+func (fk testFeeGrantKeeper) GetAllowance(_ context.Context, _, grantee sdk.AccAddress) (feegrant.FeeAllowanceI, error) {
+	if !fk.grantees[grantee.String()] {
+		return nil, errors.New("fee-grant not found")
+	}
+	return &feegrant.BasicAllowance{}, nil
+}
+
+func (fk testFeeGrantKeeper) UseGrantedFees(_ context.Context, _, _ sdk.AccAddress, _ sdk.Coins, _ []sdk.Msg) error {
+	if fk.reject {
+		return errors.New("fee limit exceeded")
+	}
+	return nil
+}
+
+// testFeeAccountKeeper returns a base account for every address.
+type testFeeAccountKeeper struct {
+	authante.AccountKeeper
+}
+
+func (testFeeAccountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return authtypes.NewBaseAccountWithAddress(addr)
+}
+
+// testFeeBankKeeper records the accounts paying and receiving coins from the
+// fee collector.
+type testFeeBankKeeper struct {
+	evmtypes.BankKeeper
+	payers     []sdk.AccAddress
+	recipients []sdk.AccAddress
+}
+
+func (bk *testFeeBankKeeper) SendCoinsFromAccountToModule(_ context.Context, sender sdk.AccAddress, _ string, _ sdk.Coins) error {
+	bk.payers = append(bk.payers, sender)
+	return nil
+}
+
+func (bk *testFeeBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, _ string, recipient sdk.AccAddress, _ sdk.Coins) error {
+	bk.recipients = append(bk.recipients, recipient)
+	return nil
+}
+
+// testDeductEVMKeeper records the senders deducted the fees of their txs.
+type testDeductEVMKeeper struct {
+	ethermintante.EVMKeeper
+	payers []common.Address
+}
+
+func (k *testDeductEVMKeeper) DeductTxCostsFromUserBalance(_ sdk.Context, _ sdk.Coins, from common.Address) error {
+	k.payers = append(k.payers, from)
+	return nil
+}
+
+var (
+	testGranter  = sdk.AccAddress([]byte("granter_____________"))
+	testSender   = common.HexToAddress("0x2000000000000000000000000000000000000001")
+	testContract = common.HexToAddress("0x1000000000000000000000000000000000000001")
+)
+
+func newTestFeeGrantTx(t *testing.T, from common.Address, to *common.Address) (sdk.Tx, *evmtypes.MsgEthereumTx) {
+	t.Helper()
+
+	msg := evmtypes.NewTx(big.NewInt(2390), 0, to, big.NewInt(0), 100_000, big.NewInt(1), nil, nil, nil, nil)
+	tx, err := msg.BuildTx(MakeEncodingConfig().TxConfig.NewTxBuilder(), BaseDenom)
+	require.NoError(t, err)
+	msg.From = from.Hex()
+	return tx, msg
+}
+
+func TestEthFeeGrantDecorator(t *testing.T) {
+	ctx := newTestMempoolContext(t)
+	other := common.HexToAddress("0x1000000000000000000000000000000000000002")
+	sponsorKeeper := testSponsorKeeper{testContract: testGranter, other: sdk.AccAddress(testSender.Bytes())}
+	feegrantKeeper := testFeeGrantKeeper{grantees: map[string]bool{sdk.AccAddress(testSender.Bytes()).String(): true}}
+
+	testCases := []struct {
+		name    string
+		from    common.Address
+		to      *common.Address
+		granted bool
+	}{
+		{"sponsored contract", testSender, &testContract, true},
+		{"no allowance", common.HexToAddress("0x2000000000000000000000000000000000000002"), &testContract, false},
+		{"contract sponsored by the sender", testSender, &other, false},
+		{"not sponsored contract", testSender, &common.Address{1}, false},
+		{"contract creation", testSender, nil, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tx, _ := newTestFeeGrantTx(t, tc.from, tc.to)
+
+			var grant *ethFeeGrant
+			next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				grant = ethFeeGrantFromContext(ctx, tc.from)
+				return ctx, nil
+			}
+			_, err := NewEthFeeGrantDecorator(sponsorKeeper, feegrantKeeper).AnteHandle(ctx, tx, false, next)
+			require.NoError(t, err)
+			if tc.granted {
+				require.NotNil(t, grant)
+				require.Equal(t, testGranter, grant.granter)
+			} else {
+				require.Nil(t, grant)
+			}
+		})
+	}
+}
+
+func TestEthFeeGrantEVMKeeper(t *testing.T) {
+	fees := sdk.NewCoins(sdk.NewCoin(BaseDenom, sdkmath.NewInt(100)))
+
+	for _, reject := range []bool{false, true} {
+		_, msg := newTestFeeGrantTx(t, testSender, &testContract)
+		grant := &ethFeeGrant{granter: testGranter, sender: testSender, msg: msg}
+		ctx := newTestMempoolContext(t).WithValue(ethFeeGrantKey{}, grant)
+
+		evmKeeper := &testDeductEVMKeeper{}
+		bankKeeper := &testFeeBankKeeper{}
+		k := ethFeeGrantEVMKeeper{
+			EVMKeeper:      evmKeeper,
+			accountKeeper:  testFeeAccountKeeper{},
+			bankKeeper:     bankKeeper,
+			feegrantKeeper: testFeeGrantKeeper{reject: reject},
+		}
+		require.NoError(t, k.DeductTxCostsFromUserBalance(ctx, fees, testSender))
+
+		if reject {
+			// the sender pays the fees the allowance rejects
+			require.False(t, grant.used)
+			require.Empty(t, bankKeeper.payers)
+			require.Equal(t, []common.Address{testSender}, evmKeeper.payers)
+		} else {
+			require.True(t, grant.used)
+			require.Equal(t, []sdk.AccAddress{testGranter}, bankKeeper.payers)
+			require.Empty(t, evmKeeper.payers)
+		}
+
+		// the fees of other senders are not paid from the grant
+		other := common.HexToAddress("0x2000000000000000000000000000000000000002")
+		require.NoError(t, k.DeductTxCostsFromUserBalance(ctx, fees, other))
+		require.Equal(t, other, evmKeeper.payers[len(evmKeeper.payers)-1])
+	}
+}
+
+func TestEthFeeGrantBankKeeper(t *testing.T) {
+	refund := sdk.NewCoins(sdk.NewCoin(BaseDenom, sdkmath.NewInt(10)))
+	sender := sdk.AccAddress(testSender.Bytes())
+
+	for _, used := range []bool{false, true} {
+		grant := &ethFeeGrant{granter: testGranter, sender: testSender, used: used}
+		ctx := newTestMempoolContext(t).WithValue(ethFeeGrantKey{}, grant)
+		bankKeeper := &testFeeBankKeeper{}
+		bk := NewEthFeeGrantBankKeeper(bankKeeper)
+
+		require.NoError(t, bk.SendCoinsFromModuleToAccount(ctx, authtypes.FeeCollectorName, sender, refund))
+		require.NoError(t, bk.SendCoinsFromModuleToAccount(ctx, evmtypes.ModuleName, sender, refund))
+
+		if used {
+			// only the refunds from the fee collector go to the granter
+			require.Equal(t, []sdk.AccAddress{testGranter, sender}, bankKeeper.recipients)
+		} else {
+			require.Equal(t, []sdk.AccAddress{sender, sender}, bankKeeper.recipients)
+		}
+	}
+}
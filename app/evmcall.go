@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	circuitante "cosmossdk.io/x/circuit/ante"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmcalltypes "github.com/Asphere-xyz/tacchain/x/evmcall/types"
+)
+
+var _ evmcalltypes.CallFilter = EthCallFilter{}
+
+// EthCallFilter applies the checks of the Ethereum txs to the contract calls
+// of x/evmcall, which are not Ethereum txs and don't go through their ante
+// handler: the calls paused by the circuit breaker, see
+// EthCircuitBreakerDecorator, and the calls of blocked contracts, see
+// EthBlocklistDecorator, are rejected. The blocked senders are rejected by
+// BlocklistDecorator.
+type EthCallFilter struct {
+	circuitKeeper   circuitante.CircuitBreaker
+	blocklistKeeper BlocklistKeeper
+}
+
+// NewEthCallFilter creates a new EthCallFilter.
+func NewEthCallFilter(ck circuitante.CircuitBreaker, bk BlocklistKeeper) EthCallFilter {
+	return EthCallFilter{
+		circuitKeeper:   ck,
+		blocklistKeeper: bk,
+	}
+}
+
+// CheckCall implements evmcalltypes.CallFilter.
+func (f EthCallFilter) CheckCall(ctx sdk.Context, contract common.Address, data []byte) error {
+	if err := checkEthCircuitBreaker(ctx, f.circuitKeeper, &contract, data); err != nil {
+		return err
+	}
+	return checkBlocked(ctx, f.blocklistKeeper, contract.Bytes())
+}
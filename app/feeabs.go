@@ -0,0 +1,202 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"bytes"
+	"context"
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	feeabstypes "github.com/Asphere-xyz/tacchain/x/feeabs/types"
+)
+
+var _ sdk.AnteDecorator = FeeAbsDecorator{}
+
+// FeeAbsKeeper returns the conversion rates of the denoms accepted for fees.
+type FeeAbsKeeper interface {
+	ConversionRate(ctx context.Context, denom string) (sdkmath.LegacyDec, bool, error)
+}
+
+// CommunityPoolKeeper funds the community pool with the fees paid in other
+// denoms than the base denom.
+type CommunityPoolKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// FeeAbsBankKeeper defines the bank methods used to pay the fees in the base
+// denom from the feeabs reserve.
+type FeeAbsBankKeeper interface {
+	authtypes.BankKeeper
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+}
+
+// FeeAbsDecorator deducts the fees of Cosmos txs, which can be paid in a denom
+// accepted by the feeabs module instead of the base denom. Such fees are
+// converted at the rate of the feeabs module: the fee collector is paid their
+// base denom value from the feeabs module account, a reserve funded by
+// governance, and the fees themselves go to the community pool.
+//
+// Only the fees of a single accepted denom are converted; the other fees are
+// deducted by the SDK DeductFeeDecorator.
+type FeeAbsDecorator struct {
+	accountKeeper       authante.AccountKeeper
+	bankKeeper          FeeAbsBankKeeper
+	feegrantKeeper      authante.FeegrantKeeper
+	feeAbsKeeper        FeeAbsKeeper
+	communityPoolKeeper CommunityPoolKeeper
+	deductFee           authante.DeductFeeDecorator
+}
+
+// NewFeeAbsDecorator creates a new FeeAbsDecorator.
+func NewFeeAbsDecorator(
+	ak authante.AccountKeeper,
+	bk FeeAbsBankKeeper,
+	fk authante.FeegrantKeeper,
+	fak FeeAbsKeeper,
+	cpk CommunityPoolKeeper,
+) FeeAbsDecorator {
+	return FeeAbsDecorator{
+		accountKeeper:       ak,
+		bankKeeper:          bk,
+		feegrantKeeper:      fk,
+		feeAbsKeeper:        fak,
+		communityPoolKeeper: cpk,
+		deductFee:           authante.NewDeductFeeDecorator(ak, bk, fk, nil),
+	}
+}
+
+// AnteHandle deducts the fees of the tx, converting them to the base denom if
+// they're paid in an accepted denom.
+func (fad FeeAbsDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(errortypes.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	fee := feeTx.GetFee()
+	if len(fee) != 1 || fee[0].Denom == BaseDenom {
+		return fad.deductFee.AnteHandle(ctx, tx, simulate, next)
+	}
+	rate, ok, err := fad.feeAbsKeeper.ConversionRate(ctx, fee[0].Denom)
+	if err != nil {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "cannot pay fees in %s: %s", fee[0].Denom, err)
+	}
+	if !ok {
+		return fad.deductFee.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	gas := feeTx.GetGas()
+	if !simulate && ctx.BlockHeight() > 0 && gas == 0 {
+		return ctx, errorsmod.Wrap(errortypes.ErrInvalidGasLimit, "must provide positive gas")
+	}
+
+	baseFee := sdk.NewCoins(sdk.NewCoin(BaseDenom, rate.MulInt(fee[0].Amount).TruncateInt()))
+
+	var priority int64
+	if !simulate {
+		if ctx.IsCheckTx() {
+			if err := checkMinGasPrices(ctx, baseFee, gas); err != nil {
+				return ctx, errorsmod.Wrapf(err, "%s converted to %s", fee, baseFee)
+			}
+		}
+		priority = feeAbsTxPriority(baseFee, gas)
+	}
+
+	if err := fad.deductFees(ctx, tx, fee, baseFee); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx.WithPriority(priority), tx, simulate)
+}
+
+// deductFees sends the fees of the tx to the community pool and their base
+// denom value from the feeabs reserve to the fee collector. The fees are paid
+// from the allowance of the fee granter, if any.
+func (fad FeeAbsDecorator) deductFees(ctx sdk.Context, tx sdk.Tx, fee, baseFee sdk.Coins) error {
+	feeTx := tx.(sdk.FeeTx)
+
+	if addr := fad.accountKeeper.GetModuleAddress(feeabstypes.ModuleName); addr == nil {
+		return errorsmod.Wrapf(errortypes.ErrLogic, "%s module account has not been set", feeabstypes.ModuleName)
+	}
+
+	feePayer := sdk.AccAddress(feeTx.FeePayer())
+	deductFeesFrom := feePayer
+	if feeGranter := feeTx.FeeGranter(); feeGranter != nil {
+		feeGranterAddr := sdk.AccAddress(feeGranter)
+		if fad.feegrantKeeper == nil {
+			return errortypes.ErrInvalidRequest.Wrap("fee grants are not enabled")
+		} else if !bytes.Equal(feeGranterAddr, feePayer) {
+			if err := fad.feegrantKeeper.UseGrantedFees(ctx, feeGranterAddr, feePayer, fee, tx.GetMsgs()); err != nil {
+				return errorsmod.Wrapf(err, "%s does not allow to pay fees for %s", feeGranterAddr, feePayer)
+			}
+		}
+		deductFeesFrom = feeGranterAddr
+	}
+
+	if fad.accountKeeper.GetAccount(ctx, deductFeesFrom) == nil {
+		return errortypes.ErrUnknownAddress.Wrapf("fee payer address: %s does not exist", deductFeesFrom)
+	}
+
+	if !fee.IsZero() {
+		if !fee.IsValid() {
+			return errorsmod.Wrapf(errortypes.ErrInsufficientFee, "invalid fee amount: %s", fee)
+		}
+		if err := fad.communityPoolKeeper.FundCommunityPool(ctx, fee, deductFeesFrom); err != nil {
+			return errorsmod.Wrap(errortypes.ErrInsufficientFunds, err.Error())
+		}
+	}
+	if !baseFee.IsZero() {
+		if err := fad.bankKeeper.SendCoinsFromModuleToModule(ctx, feeabstypes.ModuleName, authtypes.FeeCollectorName, baseFee); err != nil {
+			return errorsmod.Wrapf(errortypes.ErrInsufficientFunds, "%s reserve cannot pay %s: %s", feeabstypes.ModuleName, baseFee, err)
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeTx,
+		sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
+		sdk.NewAttribute(sdk.AttributeKeyFeePayer, deductFeesFrom.String()),
+	))
+	return nil
+}
+
+// checkMinGasPrices checks the fees, in the base denom, against the minimum
+// gas prices of the validator.
+func checkMinGasPrices(ctx sdk.Context, baseFee sdk.Coins, gas uint64) error {
+	minGasPrices := ctx.MinGasPrices()
+	if minGasPrices.IsZero() {
+		return nil
+	}
+
+	glDec := sdkmath.LegacyNewDec(int64(gas))
+	requiredFees := make(sdk.Coins, 0, len(minGasPrices))
+	for _, gp := range minGasPrices {
+		requiredFees = append(requiredFees, sdk.NewCoin(gp.Denom, gp.Amount.Mul(glDec).Ceil().RoundInt()))
+	}
+	if !baseFee.IsAnyGTE(requiredFees) {
+		return errorsmod.Wrapf(errortypes.ErrInsufficientFee, "insufficient fees; got: %s required: %s", baseFee, requiredFees)
+	}
+	return nil
+}
+
+// feeAbsTxPriority returns the gas price of the fees in the base denom, like
+// the SDK for fees paid in the base denom.
+func feeAbsTxPriority(baseFee sdk.Coins, gas uint64) int64 {
+	if gas == 0 || baseFee.IsZero() {
+		return 0
+	}
+	gasPrice := baseFee[0].Amount.Quo(sdkmath.NewIntFromUint64(gas))
+	if !gasPrice.IsInt64() {
+		return math.MaxInt64
+	}
+	return gasPrice.Int64()
+}
@@ -0,0 +1,147 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	feeabstypes "github.com/Asphere-xyz/tacchain/x/feeabs/types"
+)
+
+// testFeeAbsKeeper holds the conversion rates of the accepted fee denoms.
+type testFeeAbsKeeper map[string]sdkmath.LegacyDec
+
+func (k testFeeAbsKeeper) ConversionRate(_ context.Context, denom string) (sdkmath.LegacyDec, bool, error) {
+	rate, ok := k[denom]
+	return rate, ok, nil
+}
+
+// testCommunityPoolKeeper records the coins funding the community pool.
+type testCommunityPoolKeeper struct {
+	funds   sdk.Coins
+	senders []sdk.AccAddress
+}
+
+func (k *testCommunityPoolKeeper) FundCommunityPool(_ context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	k.funds = k.funds.Add(amount...)
+	k.senders = append(k.senders, sender)
+	return nil
+}
+
+// testFeeAbsAccountKeeper returns a base account for every address, and the
+// module addresses.
+type testFeeAbsAccountKeeper struct {
+	testFeeAccountKeeper
+}
+
+func (testFeeAbsAccountKeeper) GetModuleAddress(name string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(name)
+}
+
+// testFeeAbsBankKeeper records the fees paid to the fee collector, from the
+// accounts or the feeabs reserve, which holds reserve.
+type testFeeAbsBankKeeper struct {
+	testFeeBankKeeper
+	reserve   sdk.Coins
+	collected sdk.Coins
+}
+
+func (bk *testFeeAbsBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, sender sdk.AccAddress, module string, amt sdk.Coins) error {
+	bk.collected = bk.collected.Add(amt...)
+	return bk.testFeeBankKeeper.SendCoinsFromAccountToModule(ctx, sender, module, amt)
+}
+
+func (bk *testFeeAbsBankKeeper) SendCoinsFromModuleToModule(_ context.Context, sender, _ string, amt sdk.Coins) error {
+	if sender != feeabstypes.ModuleName {
+		return errors.New("unexpected sender module")
+	}
+	reserve, negative := bk.reserve.SafeSub(amt...)
+	if negative {
+		return errors.New("insufficient funds")
+	}
+	bk.reserve = reserve
+	bk.collected = bk.collected.Add(amt...)
+	return nil
+}
+
+func newTestFeeAbsTx(t *testing.T, fee sdk.Coins, gas uint64) sdk.Tx {
+	t.Helper()
+
+	from := sdk.AccAddress(testSender.Bytes())
+	builder := MakeEncodingConfig().TxConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(from, testGranter, sdk.NewCoins(sdk.NewInt64Coin(BaseDenom, 1)))))
+	builder.SetFeeAmount(fee)
+	builder.SetGasLimit(gas)
+	return builder.GetTx()
+}
+
+func TestFeeAbsDecorator(t *testing.T) {
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	feeAbsKeeper := testFeeAbsKeeper{ibcDenom: sdkmath.LegacyNewDec(1_000)}
+	sender := sdk.AccAddress(testSender.Bytes())
+
+	testCases := []struct {
+		name        string
+		fee         sdk.Coins
+		reserve     int64
+		minGasPrice int64
+		err         error
+		converted   bool
+		priority    int64
+	}{
+		{"accepted denom", sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 2_000)), 10_000_000, 10, nil, true, 20},
+		{"insufficient fees", sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 2_000)), 10_000_000, 21, errortypes.ErrInsufficientFee, false, 0},
+		{"insufficient reserve", sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 2_000)), 1_000_000, 0, errortypes.ErrInsufficientFunds, false, 0},
+		{"base denom", sdk.NewCoins(sdk.NewInt64Coin(BaseDenom, 3_000_000)), 0, 10, nil, false, 30},
+		{"other denom", sdk.NewCoins(sdk.NewInt64Coin("uatom", 2_000)), 0, 0, nil, false, 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := newTestMempoolContext(t).WithBlockHeight(1).WithIsCheckTx(true).
+				WithMinGasPrices(sdk.NewDecCoins(sdk.NewInt64DecCoin(BaseDenom, tc.minGasPrice)))
+			bankKeeper := &testFeeAbsBankKeeper{reserve: sdk.NewCoins(sdk.NewInt64Coin(BaseDenom, tc.reserve))}
+			communityPoolKeeper := &testCommunityPoolKeeper{}
+			dec := NewFeeAbsDecorator(testFeeAbsAccountKeeper{}, bankKeeper, testFeeGrantKeeper{}, feeAbsKeeper, communityPoolKeeper)
+
+			var priority int64
+			next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				priority = ctx.Priority()
+				return ctx, nil
+			}
+			_, err := dec.AnteHandle(ctx, newTestFeeAbsTx(t, tc.fee, 100_000), false, next)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				require.True(t, bankKeeper.collected.IsZero())
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.priority, priority)
+
+			if tc.converted {
+				// the fees go to the community pool, and their value in the base denom
+				// from the reserve to the fee collector
+				require.Equal(t, tc.fee, communityPoolKeeper.funds)
+				require.Equal(t, []sdk.AccAddress{sender}, communityPoolKeeper.senders)
+				require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(BaseDenom, 2_000_000)), bankKeeper.collected)
+			} else {
+				require.Empty(t, communityPoolKeeper.senders)
+				require.Equal(t, tc.fee, bankKeeper.collected)
+				require.Equal(t, []sdk.AccAddress{sender}, bankKeeper.payers)
+			}
+		})
+	}
+}
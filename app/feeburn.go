@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ethermintante "github.com/evmos/ethermint/app/ante"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+var _ sdk.PostDecorator = FeeBurnDecorator{}
+
+// FeeBurnKeeper burns the base fee of the txs and shares the rest of their
+// fees between the community pool and the stakers.
+type FeeBurnKeeper interface {
+	SplitFees(ctx sdk.Context, baseFee, tips sdk.Coins) error
+}
+
+// FeeBurnEVMKeeper defines the EVM keeper methods used to compute the base fee
+// and the gas used of the txs.
+type FeeBurnEVMKeeper interface {
+	ethermintante.DynamicFeeEVMKeeper
+	GetTransientGasUsed(ctx sdk.Context) uint64
+}
+
+// FeeBurnDecorator splits the fees a tx paid to the fee collector into the
+// base fee, the gas used times the EIP-1559 base fee, and the tips, and has the
+// feeburn module burn and share them. It runs in block execution only, after
+// the Ethereum txs refunded their unused gas.
+//
+// The fees of Cosmos txs paid in a denom accepted by the feeabs module are
+// converted to the base denom, like the fee collector was paid. As the gas used
+// by each message of an Ethereum tx isn't known, the tips of a tx with several
+// messages are computed with the lowest gas price, which never shares more than
+// was paid; the difference stays with the stakers.
+//
+// The fees paid to the contracts registered in the revenue module, by the
+// RevenueDecorator running before, are neither burned nor shared again: they
+// are deducted from the base fee and the tips in proportion to them.
+type FeeBurnDecorator struct {
+	txFees
+	feeBurnKeeper FeeBurnKeeper
+}
+
+// NewFeeBurnDecorator creates a new FeeBurnDecorator.
+func NewFeeBurnDecorator(fbk FeeBurnKeeper, ek FeeBurnEVMKeeper, fak FeeAbsKeeper) FeeBurnDecorator {
+	return FeeBurnDecorator{
+		txFees:        txFees{evmKeeper: ek, feeAbsKeeper: fak},
+		feeBurnKeeper: fbk,
+	}
+}
+
+// PostHandle burns and shares the fees of the tx. The work isn't charged to the
+// tx, so that the gas used of Ethereum txs matches their receipts.
+func (fbd FeeBurnDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if simulate || !success || ctx.IsCheckTx() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	splitCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	baseFee, tips, err := fbd.split(splitCtx, tx)
+	if err != nil {
+		return ctx, err
+	}
+	if paid, ok := ctx.Value(revenuePaidKey{}).(sdk.Coins); ok {
+		baseFee, tips = deductRevenue(baseFee, tips, paid)
+	}
+
+	if err := fbd.feeBurnKeeper.SplitFees(splitCtx, baseFee, tips); err != nil {
+		return ctx, errorsmod.Wrap(err, "failed to split fees")
+	}
+	return next(ctx, tx, simulate, success)
+}
+
+// txFees splits the fees a tx paid to the fee collector into the base fee and
+// the tips.
+type txFees struct {
+	evmKeeper    FeeBurnEVMKeeper
+	feeAbsKeeper FeeAbsKeeper
+}
+
+// split returns the base fee and the tips paid by a tx.
+func (tf txFees) split(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, sdk.Coins, error) {
+	evmParams := tf.evmKeeper.GetParams(ctx)
+	baseFee := tf.evmKeeper.GetBaseFee(ctx, evmParams.GetChainConfig().EthereumConfig(tf.evmKeeper.ChainID()))
+	if baseFee == nil {
+		baseFee = new(big.Int)
+	}
+
+	var (
+		baseFeeAmount, tipsAmount sdkmath.Int
+		denom                     string
+		err                       error
+	)
+	if TxLane(tx) == LaneEVM {
+		denom = evmParams.EvmDenom
+		baseFeeAmount, tipsAmount, err = tf.ethFees(ctx, tx, baseFee)
+	} else {
+		denom = BaseDenom
+		baseFeeAmount, tipsAmount, err = tf.cosmosFees(ctx, tx, baseFee)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return sdk.NewCoins(sdk.NewCoin(denom, baseFeeAmount)), sdk.NewCoins(sdk.NewCoin(denom, tipsAmount)), nil
+}
+
+// ethFees returns the base fee and the tips paid by the gas used of an Ethereum
+// tx.
+func (tf txFees) ethFees(ctx sdk.Context, tx sdk.Tx, baseFee *big.Int) (sdkmath.Int, sdkmath.Int, error) {
+	var gasPrice *big.Int
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return sdkmath.Int{}, sdkmath.Int{}, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+		txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
+		if err != nil {
+			return sdkmath.Int{}, sdkmath.Int{}, err
+		}
+		if price := txData.EffectiveGasPrice(baseFee); gasPrice == nil || price.Cmp(gasPrice) < 0 {
+			gasPrice = price
+		}
+	}
+	if gasPrice == nil {
+		return sdkmath.ZeroInt(), sdkmath.ZeroInt(), nil
+	}
+
+	gasUsed := sdkmath.NewIntFromUint64(tf.evmKeeper.GetTransientGasUsed(ctx))
+	fees := gasUsed.Mul(sdkmath.NewIntFromBigInt(gasPrice))
+	baseFeeAmount, tips := splitBaseFee(fees, gasUsed.Mul(sdkmath.NewIntFromBigInt(baseFee)))
+	return baseFeeAmount, tips, nil
+}
+
+// cosmosFees returns the base fee and the tips paid by the gas limit of a
+// Cosmos tx, in the base denom.
+func (tf txFees) cosmosFees(ctx sdk.Context, tx sdk.Tx, baseFee *big.Int) (sdkmath.Int, sdkmath.Int, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return sdkmath.Int{}, sdkmath.Int{}, errorsmod.Wrap(errortypes.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	fee := feeTx.GetFee()
+	fees := fee.AmountOf(BaseDenom)
+	if len(fee) == 1 && fee[0].Denom != BaseDenom && tf.feeAbsKeeper != nil {
+		rate, ok, err := tf.feeAbsKeeper.ConversionRate(ctx, fee[0].Denom)
+		if err != nil {
+			return sdkmath.Int{}, sdkmath.Int{}, err
+		}
+		if ok {
+			fees = rate.MulInt(fee[0].Amount).TruncateInt()
+		}
+	}
+
+	gas := sdkmath.NewIntFromUint64(feeTx.GetGas())
+	baseFeeAmount, tips := splitBaseFee(fees, gas.Mul(sdkmath.NewIntFromBigInt(baseFee)))
+	return baseFeeAmount, tips, nil
+}
+
+// deductRevenue deducts the revenue paid, at most the fees, from the base fee
+// and the tips in proportion to them.
+func deductRevenue(baseFee, tips, paid sdk.Coins) (sdk.Coins, sdk.Coins) {
+	fees := baseFee.Add(tips...)
+	rest := fees.Sub(paid...)
+	restBaseFee := sdk.NewCoins()
+	for _, coin := range baseFee {
+		amount := coin.Amount.Mul(rest.AmountOf(coin.Denom)).Quo(fees.AmountOf(coin.Denom))
+		restBaseFee = restBaseFee.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	return restBaseFee, rest.Sub(restBaseFee...)
+}
+
+// splitBaseFee splits fees into the base fee, capped to the fees, and the
+// tips.
+func splitBaseFee(fees, baseFee sdkmath.Int) (sdkmath.Int, sdkmath.Int) {
+	baseFee = sdkmath.MinInt(fees, baseFee)
+	return baseFee, fees.Sub(baseFee)
+}
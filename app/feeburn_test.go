@@ -0,0 +1,127 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// testFeeBurnKeeper records the fees split.
+type testFeeBurnKeeper struct {
+	baseFee sdk.Coins
+	tips    sdk.Coins
+}
+
+func (k *testFeeBurnKeeper) SplitFees(_ sdk.Context, baseFee, tips sdk.Coins) error {
+	k.baseFee = k.baseFee.Add(baseFee...)
+	k.tips = k.tips.Add(tips...)
+	return nil
+}
+
+// testFeeBurnEVMKeeper returns a fixed base fee and gas used.
+type testFeeBurnEVMKeeper struct {
+	testAnteEVMKeeper
+	baseFee *big.Int
+	gasUsed uint64
+}
+
+func (testFeeBurnEVMKeeper) GetParams(sdk.Context) evmtypes.Params {
+	params := evmtypes.DefaultParams()
+	params.EvmDenom = BaseDenom
+	return params
+}
+
+func (k testFeeBurnEVMKeeper) GetBaseFee(sdk.Context, *params.ChainConfig) *big.Int { return k.baseFee }
+
+func (k testFeeBurnEVMKeeper) GetTransientGasUsed(sdk.Context) uint64 { return k.gasUsed }
+
+func TestFeeBurnDecorator(t *testing.T) {
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	feeAbsKeeper := testFeeAbsKeeper{ibcDenom: sdkmath.LegacyNewDec(1_000)}
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(BaseDenom, amount)) }
+	newEthTx := func(gasPrice, gasFeeCap, gasTipCap *big.Int) sdk.Tx {
+		to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+		var accesses *ethtypes.AccessList
+		if gasFeeCap != nil {
+			accesses = &ethtypes.AccessList{}
+		}
+		msg := evmtypes.NewTx(big.NewInt(2390), 0, &to, big.NewInt(0), 100_000, gasPrice, gasFeeCap, gasTipCap, nil, accesses)
+		tx, err := msg.BuildTx(MakeEncodingConfig().TxConfig.NewTxBuilder(), BaseDenom)
+		require.NoError(t, err)
+		return tx
+	}
+
+	testCases := []struct {
+		name     string
+		tx       sdk.Tx
+		burnable sdk.Coins
+		tips     sdk.Coins
+	}{
+		{
+			name:     "legacy ethereum tx",
+			tx:       newEthTx(big.NewInt(10), nil, nil),
+			burnable: coins(21_000 * 7),
+			tips:     coins(21_000 * 3),
+		},
+		{
+			name:     "dynamic fee ethereum tx",
+			tx:       newEthTx(nil, big.NewInt(20), big.NewInt(2)),
+			burnable: coins(21_000 * 7),
+			tips:     coins(21_000 * 2),
+		},
+		{
+			name:     "cosmos tx",
+			tx:       newTestFeeAbsTx(t, coins(1_000_000), 100_000),
+			burnable: coins(700_000),
+			tips:     coins(300_000),
+		},
+		{
+			name:     "cosmos tx paying less than the base fee",
+			tx:       newTestFeeAbsTx(t, coins(500_000), 100_000),
+			burnable: coins(500_000),
+			tips:     coins(0),
+		},
+		{
+			name:     "cosmos tx paying in another denom",
+			tx:       newTestFeeAbsTx(t, sdk.NewCoins(sdk.NewInt64Coin(ibcDenom, 1_000)), 100_000),
+			burnable: coins(700_000),
+			tips:     coins(300_000),
+		},
+	}
+
+	evmKeeper := testFeeBurnEVMKeeper{baseFee: big.NewInt(7), gasUsed: 21_000}
+	next := func(ctx sdk.Context, _ sdk.Tx, _, _ bool) (sdk.Context, error) { return ctx, nil }
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := newTestMempoolContext(t)
+			feeBurnKeeper := &testFeeBurnKeeper{}
+			decorator := NewFeeBurnDecorator(feeBurnKeeper, evmKeeper, feeAbsKeeper)
+
+			_, err := decorator.PostHandle(ctx, tc.tx, false, true, next)
+			require.NoError(t, err)
+			require.Equal(t, tc.burnable, feeBurnKeeper.baseFee)
+			require.Equal(t, tc.tips, feeBurnKeeper.tips)
+
+			// the fees are only split in block execution
+			for _, ctx := range []sdk.Context{ctx.WithIsCheckTx(true), ctx} {
+				_, err = decorator.PostHandle(ctx, tc.tx, !ctx.IsCheckTx(), true, next)
+				require.NoError(t, err)
+			}
+			require.Equal(t, tc.burnable, feeBurnKeeper.baseFee)
+		})
+	}
+}
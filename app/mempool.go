@@ -0,0 +1,582 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+	"slices"
+	"sync"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	ethermintante "github.com/evmos/ethermint/app/ante"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+var (
+	_ mempool.Mempool                 = (*TacMempool)(nil)
+	_ mempool.Iterator                = (*tacMempoolIterator)(nil)
+	_ mempool.SignerExtractionAdapter = EthSignerExtractionAdapter{}
+	_ sdk.AnteDecorator               = EthReplaceByFeeDecorator{}
+	_ sdk.AnteDecorator               = MempoolRecheckDecorator{}
+)
+
+// mempoolCodespace is the codespace of the errors returned when the app-side
+// mempool rejects a tx.
+const mempoolCodespace = "tacmempool"
+
+var (
+	// ErrSenderTxLimit is returned when a sender has too many pending txs.
+	ErrSenderTxLimit = errorsmod.Register(mempoolCodespace, 2, "sender exceeds the pending tx limit")
+	// ErrSenderBytesLimit is returned when the pending txs of a sender are too large.
+	ErrSenderBytesLimit = errorsmod.Register(mempoolCodespace, 3, "sender exceeds the pending bytes limit")
+	// ErrMempoolFull is returned when the mempool is full and the tx does not pay
+	// more than the txs that could be evicted.
+	ErrMempoolFull = errorsmod.Register(mempoolCodespace, 4, "mempool is full")
+	// ErrTxEvicted is returned on ReCheckTx for a tx that is no longer in the
+	// mempool, so that CometBFT drops it as well.
+	ErrTxEvicted = errorsmod.Register(mempoolCodespace, 5, "tx was evicted from the mempool")
+)
+
+// MempoolAccountKeeper defines the account keeper methods used by the mempool
+// to look up the next expected nonce of a sender.
+type MempoolAccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+}
+
+// TacMempool is the app-side mempool. It wraps the SDK priority nonce mempool
+// with a signer extractor that understands Ethereum transactions, so EVM txs are
+// ordered by effective tip and Cosmos txs by fee, while the txs of each sender
+// keep their nonce order. On top of that it indexes pending txs by sender and
+// nonce to support replace-by-fee, and skips senders with a nonce gap when txs
+// are selected for a proposal.
+//
+// The mempool limits the number and size of the pending txs of each sender and
+// in total. When the total limits are reached, the tx with the lowest priority
+// among the last pending txs of the other senders is evicted, so that the
+// nonces of every sender stay contiguous.
+type TacMempool struct {
+	pool            *mempool.PriorityNonceMempool[int64]
+	signerExtractor mempool.SignerExtractionAdapter
+	accountKeeper   MempoolAccountKeeper
+	txEncoder       sdk.TxEncoder
+	cfg             MempoolConfig
+
+	mtx         sync.RWMutex
+	pending     map[string]map[uint64]pendingTx
+	senderBytes map[string]int64
+	totalBytes  int64
+}
+
+// pendingTx is a tx of the mempool along with what is needed to enforce the
+// mempool limits.
+type pendingTx struct {
+	tx       sdk.Tx
+	priority int64
+	size     int64
+	hash     [sha256.Size]byte
+}
+
+// NewTacMempool creates the app-side mempool from the node configuration. The
+// tx encoder must produce the bytes the txs are broadcast with, it is used to
+// tell a pending tx from the tx it replaced.
+func NewTacMempool(cfg MempoolConfig, ak MempoolAccountKeeper, txEncoder sdk.TxEncoder) *TacMempool {
+	signerExtractor := NewEthSignerExtractionAdapter()
+
+	return &TacMempool{
+		// the total limits are enforced by TacMempool, which evicts txs instead of
+		// rejecting new ones
+		pool: mempool.NewPriorityMempool(mempool.PriorityNonceMempoolConfig[int64]{
+			TxPriority:      mempool.NewDefaultTxPriority(),
+			TxReplacement:   newPriceBumpTxReplacement(cfg.PriceBump),
+			SignerExtractor: signerExtractor,
+		}),
+		signerExtractor: signerExtractor,
+		accountKeeper:   ak,
+		txEncoder:       txEncoder,
+		cfg:             cfg,
+		pending:         make(map[string]map[uint64]pendingTx),
+		senderBytes:     make(map[string]int64),
+	}
+}
+
+// Insert adds a tx to the mempool. A tx reusing the sender and nonce of a
+// pending tx replaces it only if its priority is high enough, see
+// newPriceBumpTxReplacement.
+//
+// A tx is rejected with ErrSenderTxLimit or ErrSenderBytesLimit if its sender
+// would exceed its limits, and with ErrMempoolFull if the mempool is full and
+// no tx of lower priority can be evicted.
+func (mp *TacMempool) Insert(ctx context.Context, tx sdk.Tx) error {
+	signer, err := mp.firstSigner(tx)
+	if err != nil {
+		return err
+	}
+
+	ptx := pendingTx{tx: tx}
+	if sdkCtx, ok := ctx.(sdk.Context); ok {
+		ptx.priority = sdkCtx.Priority()
+		ptx.size = int64(len(sdkCtx.TxBytes()))
+		ptx.hash = sha256.Sum256(sdkCtx.TxBytes())
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	sender := signer.Signer.String()
+	replaced, isReplacement := mp.pending[sender][signer.Sequence]
+
+	senderTxs := len(mp.pending[sender])
+	totalTxs := mp.pool.CountTx()
+	if !isReplacement {
+		senderTxs++
+		totalTxs++
+	}
+	addedBytes := ptx.size - replaced.size
+
+	if mp.cfg.MaxTxsPerSender > 0 && senderTxs > mp.cfg.MaxTxsPerSender {
+		return errorsmod.Wrapf(ErrSenderTxLimit, "sender %s has %d pending txs, limit is %d", sender, senderTxs-1, mp.cfg.MaxTxsPerSender)
+	}
+	if mp.cfg.MaxBytesPerSender > 0 && mp.senderBytes[sender]+addedBytes > mp.cfg.MaxBytesPerSender {
+		return errorsmod.Wrapf(ErrSenderBytesLimit, "sender %s has %d pending bytes, limit is %d", sender, mp.senderBytes[sender], mp.cfg.MaxBytesPerSender)
+	}
+
+	// the victims are only evicted once the tx is in the mempool, so that they
+	// are kept if it is rejected
+	evicted := make(map[string]map[uint64]bool)
+	var victims []pendingTx
+	for (mp.cfg.MaxTxs > 0 && totalTxs > mp.cfg.MaxTxs) || (mp.cfg.MaxBytes > 0 && mp.totalBytes+addedBytes > mp.cfg.MaxBytes) {
+		victim, victimSender, victimNonce, ok := mp.evictionCandidate(sender, evicted)
+		if !ok || victim.priority >= ptx.priority {
+			return errorsmod.Wrapf(ErrMempoolFull, "%d txs and %d bytes pending, tx priority %d is too low to evict another tx", mp.pool.CountTx(), mp.totalBytes, ptx.priority)
+		}
+		if _, ok := evicted[victimSender]; !ok {
+			evicted[victimSender] = make(map[uint64]bool)
+		}
+		evicted[victimSender][victimNonce] = true
+		victims = append(victims, victim)
+		totalTxs--
+		addedBytes -= victim.size
+	}
+
+	if err := mp.pool.Insert(ctx, tx); err != nil {
+		return err
+	}
+
+	for victimSender, nonces := range evicted {
+		for victimNonce := range nonces {
+			mp.untrack(victimSender, victimNonce)
+		}
+	}
+	for _, victim := range victims {
+		// the victims are tracked, so they are in the pool
+		_ = mp.pool.Remove(victim.tx)
+	}
+
+	if isReplacement {
+		mp.untrack(sender, signer.Sequence)
+	}
+	if _, ok := mp.pending[sender]; !ok {
+		mp.pending[sender] = make(map[uint64]pendingTx)
+	}
+	mp.pending[sender][signer.Sequence] = ptx
+	mp.senderBytes[sender] += ptx.size
+	mp.totalBytes += ptx.size
+
+	return nil
+}
+
+// evictionCandidate returns the tx with the lowest priority among the pending
+// txs with the highest nonce of every sender but the given one, not counting
+// the txs already chosen for eviction.
+//
+// NOTE: this scans the whole mempool, which is only done once it is full.
+func (mp *TacMempool) evictionCandidate(exclude string, evicted map[string]map[uint64]bool) (pendingTx, string, uint64, bool) {
+	var (
+		victim       pendingTx
+		victimSender string
+		victimNonce  uint64
+		found        bool
+	)
+	for sender, txs := range mp.pending {
+		if sender == exclude {
+			continue
+		}
+
+		var (
+			lastNonce uint64
+			hasTx     bool
+		)
+		for nonce := range txs {
+			if !evicted[sender][nonce] && (!hasTx || nonce > lastNonce) {
+				lastNonce, hasTx = nonce, true
+			}
+		}
+		if !hasTx {
+			continue
+		}
+
+		last := txs[lastNonce]
+		// break ties by sender so that the eviction does not depend on the map order
+		if !found || last.priority < victim.priority || (last.priority == victim.priority && sender < victimSender) {
+			victim, victimSender, victimNonce, found = last, sender, lastNonce, true
+		}
+	}
+	return victim, victimSender, victimNonce, found
+}
+
+// untrack removes a tx from the pending index and the size counters.
+func (mp *TacMempool) untrack(sender string, nonce uint64) {
+	ptx, ok := mp.pending[sender][nonce]
+	if !ok {
+		return
+	}
+
+	delete(mp.pending[sender], nonce)
+	mp.senderBytes[sender] -= ptx.size
+	mp.totalBytes -= ptx.size
+	if len(mp.pending[sender]) == 0 {
+		delete(mp.pending, sender)
+		delete(mp.senderBytes, sender)
+	}
+}
+
+// Select returns an iterator over the mempool txs in priority order. Txs whose
+// nonce does not directly follow the sender's account sequence, or the
+// previously selected tx of the same sender, are skipped.
+func (mp *TacMempool) Select(ctx context.Context, txs [][]byte) mempool.Iterator {
+	inner := mp.pool.Select(ctx, txs)
+	if inner == nil {
+		return nil
+	}
+
+	iterator := &tacMempoolIterator{
+		mempool:    mp,
+		ctx:        ctx,
+		inner:      inner,
+		nextNonces: make(map[string]uint64),
+	}
+
+	return iterator.skipNonceGaps()
+}
+
+// CountTx returns the number of txs in the mempool.
+func (mp *TacMempool) CountTx() int {
+	return mp.pool.CountTx()
+}
+
+// Remove removes the given tx from the mempool. The tx pending for its sender
+// and nonce is only removed if it is the same tx, so that removing a tx that
+// was replaced by fee, as done when it fails ReCheckTx, keeps its replacement.
+//
+// NOTE: Remove is called during FinalizeBlock, where any error other than
+// mempool.ErrTxNotFound fails the tx. Since the mempool content differs between
+// nodes, every failure to locate the tx is reported as mempool.ErrTxNotFound.
+func (mp *TacMempool) Remove(tx sdk.Tx) error {
+	signer, err := mp.firstSigner(tx)
+	if err != nil {
+		return mempool.ErrTxNotFound
+	}
+	txBytes, err := mp.txEncoder(tx)
+	if err != nil {
+		return mempool.ErrTxNotFound
+	}
+
+	mp.mtx.Lock()
+	defer mp.mtx.Unlock()
+
+	ptx, ok := mp.pending[signer.Signer.String()][signer.Sequence]
+	if !ok || ptx.hash != sha256.Sum256(txBytes) {
+		return mempool.ErrTxNotFound
+	}
+	if err := mp.pool.Remove(tx); err != nil {
+		return mempool.ErrTxNotFound
+	}
+
+	mp.untrack(signer.Signer.String(), signer.Sequence)
+
+	return nil
+}
+
+// PendingTx returns the tx of the given sender and nonce if it is in the mempool.
+func (mp *TacMempool) PendingTx(sender sdk.AccAddress, nonce uint64) (sdk.Tx, bool) {
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	ptx, ok := mp.pending[sender.String()][nonce]
+	return ptx.tx, ok
+}
+
+// Contains reports whether the tx with the given bytes is pending for the
+// sender and nonce of tx.
+func (mp *TacMempool) Contains(tx sdk.Tx, txBytes []byte) bool {
+	signer, err := mp.firstSigner(tx)
+	if err != nil {
+		return false
+	}
+
+	mp.mtx.RLock()
+	defer mp.mtx.RUnlock()
+
+	ptx, ok := mp.pending[signer.Signer.String()][signer.Sequence]
+	return ok && ptx.hash == sha256.Sum256(txBytes)
+}
+
+func (mp *TacMempool) firstSigner(tx sdk.Tx) (mempool.SignerData, error) {
+	signers, err := mp.signerExtractor.GetSigners(tx)
+	if err != nil {
+		return mempool.SignerData{}, err
+	}
+	if len(signers) == 0 {
+		return mempool.SignerData{}, errors.New("tx must have at least one signer")
+	}
+	return signers[0], nil
+}
+
+// accountNonce returns the current sequence of the given account. It returns
+// false when the context carries no state, e.g. outside of block production.
+func (mp *TacMempool) accountNonce(ctx context.Context, addr sdk.AccAddress) (uint64, bool) {
+	if _, ok := ctx.(sdk.Context); !ok || mp.accountKeeper == nil {
+		return 0, false
+	}
+
+	acc := mp.accountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		return 0, true
+	}
+	return acc.GetSequence(), true
+}
+
+// tacMempoolIterator wraps the priority nonce iterator and skips txs that
+// would be rejected because of a nonce gap.
+type tacMempoolIterator struct {
+	mempool    *TacMempool
+	ctx        context.Context
+	inner      mempool.Iterator
+	nextNonces map[string]uint64
+}
+
+func (i *tacMempoolIterator) Next() mempool.Iterator {
+	i.inner = i.inner.Next()
+	return i.skipNonceGaps()
+}
+
+func (i *tacMempoolIterator) Tx() sdk.Tx {
+	return i.inner.Tx()
+}
+
+func (i *tacMempoolIterator) skipNonceGaps() mempool.Iterator {
+	for i.inner != nil {
+		signer, err := i.mempool.firstSigner(i.inner.Tx())
+		if err == nil {
+			sender := signer.Signer.String()
+			expected, ok := i.nextNonces[sender]
+			if !ok {
+				expected, ok = i.mempool.accountNonce(i.ctx, signer.Signer)
+			}
+
+			if !ok || signer.Sequence == expected {
+				i.nextNonces[sender] = signer.Sequence + 1
+				return i
+			}
+		}
+
+		i.inner = i.inner.Next()
+	}
+
+	return nil
+}
+
+// newPriceBumpTxReplacement returns a replacement rule that only accepts a tx
+// with the same sender and nonce as a pending tx if its priority is at least
+// priceBump percent higher.
+func newPriceBumpTxReplacement(priceBump uint64) func(op, np int64, oTx, nTx sdk.Tx) bool {
+	return func(op, np int64, _, _ sdk.Tx) bool {
+		if np <= op {
+			return false
+		}
+
+		// threshold = op * (100 + priceBump) / 100
+		threshold := new(big.Int).Mul(big.NewInt(op), new(big.Int).SetUint64(100+priceBump))
+		threshold.Quo(threshold, big.NewInt(100))
+
+		return big.NewInt(np).Cmp(threshold) >= 0
+	}
+}
+
+// EthSignerExtractionAdapter extracts the sender and nonce of Ethereum txs from
+// their MsgEthereumTx, and falls back to the SDK default adapter for Cosmos txs.
+// The signers of the Cosmos txs without public keys, such as the smart
+// accounts, are taken from the signers of the tx, since the default adapter
+// derives them from the public keys.
+type EthSignerExtractionAdapter struct {
+	fallback mempool.SignerExtractionAdapter
+}
+
+// NewEthSignerExtractionAdapter creates a new EthSignerExtractionAdapter.
+func NewEthSignerExtractionAdapter() EthSignerExtractionAdapter {
+	return EthSignerExtractionAdapter{
+		fallback: mempool.NewDefaultSignerExtractionAdapter(),
+	}
+}
+
+// GetSigners implements the mempool.SignerExtractionAdapter interface.
+func (a EthSignerExtractionAdapter) GetSigners(tx sdk.Tx) ([]mempool.SignerData, error) {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return a.cosmosSigners(tx)
+	}
+
+	msgEthTx, ok := msgs[0].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return a.cosmosSigners(tx)
+	}
+
+	from := msgEthTx.GetFrom()
+	if from.Empty() {
+		return nil, errors.New("ethereum tx sender is not set")
+	}
+
+	txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	return []mempool.SignerData{mempool.NewSignerData(from, txData.GetNonce())}, nil
+}
+
+// cosmosSigners returns the signers of a Cosmos tx with the sequences of their
+// signatures.
+func (a EthSignerExtractionAdapter) cosmosSigners(tx sdk.Tx) ([]mempool.SignerData, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return a.fallback.GetSigners(tx)
+	}
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+	if !slices.ContainsFunc(sigs, func(sig signingtypes.SignatureV2) bool { return sig.PubKey == nil }) {
+		return a.fallback.GetSigners(tx)
+	}
+
+	// the signatures are in the order of the signers
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return nil, err
+	}
+	if len(signers) != len(sigs) {
+		return nil, errorsmod.Wrapf(errortypes.ErrUnauthorized, "wrong number of signatures, expected %d, got %d", len(signers), len(sigs))
+	}
+	signerData := make([]mempool.SignerData, len(sigs))
+	for i, sig := range sigs {
+		signerData[i] = mempool.NewSignerData(signers[i], sig.Sequence)
+	}
+	return signerData, nil
+}
+
+// EthReplaceByFeeDecorator wraps ethermint's EthIncrementSenderSequenceDecorator
+// so that, during CheckTx, an Ethereum tx may reuse the nonce of a tx that is
+// still pending in the app-side mempool. The mempool then decides whether the
+// new tx pays enough to replace the pending one. During ReCheckTx, pending txs
+// that were replaced are rejected so that CometBFT evicts them as well.
+type EthReplaceByFeeDecorator struct {
+	ak                evmtypes.AccountKeeper
+	mempool           *TacMempool
+	incrementSequence ethermintante.EthIncrementSenderSequenceDecorator
+}
+
+// NewEthReplaceByFeeDecorator creates a new EthReplaceByFeeDecorator.
+func NewEthReplaceByFeeDecorator(ak evmtypes.AccountKeeper, mp *TacMempool) EthReplaceByFeeDecorator {
+	return EthReplaceByFeeDecorator{
+		ak:                ak,
+		mempool:           mp,
+		incrementSequence: ethermintante.NewEthIncrementSenderSequenceDecorator(ak),
+	}
+}
+
+// AnteHandle skips the sender sequence increment for replacement txs and
+// delegates to EthIncrementSenderSequenceDecorator otherwise.
+func (d EthReplaceByFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	msgs := tx.GetMsgs()
+	if d.mempool == nil || !ctx.IsCheckTx() || simulate || len(msgs) != 1 {
+		return d.incrementSequence.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	msgEthTx, ok := msgs[0].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msgs[0], (*evmtypes.MsgEthereumTx)(nil))
+	}
+
+	txData, err := evmtypes.UnpackTxData(msgEthTx.Data)
+	if err != nil {
+		return ctx, errorsmod.Wrap(err, "failed to unpack tx data")
+	}
+
+	from := msgEthTx.GetFrom()
+	pending, found := d.mempool.PendingTx(from, txData.GetNonce())
+	if !found || isSameEthTx(pending, msgEthTx) {
+		return d.incrementSequence.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	if ctx.IsReCheckTx() {
+		return ctx, errorsmod.Wrapf(
+			errortypes.ErrInvalidSequence,
+			"tx with nonce %d has been replaced by another pending tx", txData.GetNonce(),
+		)
+	}
+
+	acc := d.ak.GetAccount(ctx, from)
+	if acc == nil || txData.GetNonce() >= acc.GetSequence() {
+		return d.incrementSequence.AnteHandle(ctx, tx, simulate, next)
+	}
+
+	// the sequence was already incremented by the pending tx, the mempool applies
+	// the replacement rule on insert
+	return next(ctx, tx, simulate)
+}
+
+func isSameEthTx(tx sdk.Tx, msgEthTx *evmtypes.MsgEthereumTx) bool {
+	msgs := tx.GetMsgs()
+	if len(msgs) != 1 {
+		return false
+	}
+
+	pendingMsg, ok := msgs[0].(*evmtypes.MsgEthereumTx)
+	if !ok {
+		return false
+	}
+
+	return pendingMsg.AsTransaction().Hash() == msgEthTx.AsTransaction().Hash()
+}
+
+// MempoolRecheckDecorator rejects, during ReCheckTx, the txs that are no longer
+// in the app-side mempool because they were evicted or replaced, so that
+// CometBFT drops them from its own mempool as well.
+type MempoolRecheckDecorator struct {
+	mempool *TacMempool
+}
+
+// NewMempoolRecheckDecorator creates a new MempoolRecheckDecorator.
+func NewMempoolRecheckDecorator(mp *TacMempool) MempoolRecheckDecorator {
+	return MempoolRecheckDecorator{mempool: mp}
+}
+
+// AnteHandle implements sdk.AnteDecorator.
+func (d MempoolRecheckDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if d.mempool != nil && ctx.IsReCheckTx() && !d.mempool.Contains(tx, ctx.TxBytes()) {
+		return ctx, ErrTxEvicted
+	}
+	return next(ctx, tx, simulate)
+}
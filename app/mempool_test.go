@@ -0,0 +1,293 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+func newTestEthTx(t *testing.T, from common.Address, nonce uint64) sdk.Tx {
+	t.Helper()
+
+	return newTestEthTxWithGasPrice(t, from, nonce, big.NewInt(1))
+}
+
+func newTestEthTxWithGasPrice(t *testing.T, from common.Address, nonce uint64, gasPrice *big.Int) sdk.Tx {
+	t.Helper()
+
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	msg := evmtypes.NewTx(big.NewInt(2390), nonce, &to, big.NewInt(1), 21000, gasPrice, nil, nil, nil, nil)
+
+	tx, err := msg.BuildTx(MakeEncodingConfig().TxConfig.NewTxBuilder(), BaseDenom)
+	require.NoError(t, err)
+
+	// the sender is set by the sig verification decorator during CheckTx
+	msg.From = from.Hex()
+
+	return tx
+}
+
+func newTestCosmosTx(t *testing.T, priv *secp256k1.PrivKey, sequence uint64) sdk.Tx {
+	t.Helper()
+
+	addr := sdk.AccAddress(priv.PubKey().Address())
+	return newTestCosmosTxWithMsgs(t, priv, sequence, 0, banktypes.NewMsgSend(addr, addr, sdk.NewCoins()))
+}
+
+func newTestCosmosTxWithMsgs(t *testing.T, priv *secp256k1.PrivKey, sequence, gas uint64, msgs ...sdk.Msg) sdk.Tx {
+	t.Helper()
+
+	txBuilder := MakeEncodingConfig().TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(msgs...))
+	txBuilder.SetGasLimit(gas)
+	require.NoError(t, txBuilder.SetSignatures(signing.SignatureV2{
+		PubKey:   priv.PubKey(),
+		Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+		Sequence: sequence,
+	}))
+
+	return txBuilder.GetTx()
+}
+
+// withTestTxBytes sets the encoded tx on the context, as CometBFT does in CheckTx.
+func withTestTxBytes(t *testing.T, ctx sdk.Context, tx sdk.Tx) sdk.Context {
+	t.Helper()
+
+	bz, err := MakeEncodingConfig().TxConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+	return ctx.WithTxBytes(bz)
+}
+
+func newTestMempoolContext(t *testing.T) sdk.Context {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey("mempool")
+	return testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_mempool")).WithLogger(log.NewNopLogger())
+}
+
+func selectAll(ctx context.Context, mp mempool.Mempool) []sdk.Tx {
+	var txs []sdk.Tx
+	for it := mp.Select(ctx, nil); it != nil; it = it.Next() {
+		txs = append(txs, it.Tx())
+	}
+	return txs
+}
+
+func TestTacMempoolOrdering(t *testing.T) {
+	mp := NewTacMempool(DefaultTacConfig().Mempool, nil, MakeEncodingConfig().TxConfig.TxEncoder())
+	ctx := newTestMempoolContext(t)
+
+	alice := common.HexToAddress("0xa11ce00000000000000000000000000000000000")
+	bob := common.HexToAddress("0xb0b0000000000000000000000000000000000000")
+	carol := secp256k1.GenPrivKey()
+
+	aliceTx0 := newTestEthTx(t, alice, 0)
+	aliceTx1 := newTestEthTx(t, alice, 1)
+	bobTx0 := newTestEthTx(t, bob, 0)
+	carolTx0 := newTestCosmosTx(t, carol, 0)
+
+	// alice's second tx pays more, but must still come after her first one
+	require.NoError(t, mp.Insert(withTestTxBytes(t, ctx, aliceTx0).WithPriority(10), aliceTx0))
+	require.NoError(t, mp.Insert(ctx.WithPriority(50), aliceTx1))
+	require.NoError(t, mp.Insert(ctx.WithPriority(30), bobTx0))
+	require.NoError(t, mp.Insert(ctx.WithPriority(20), carolTx0))
+	require.Equal(t, 4, mp.CountTx())
+
+	require.Equal(t, []sdk.Tx{bobTx0, carolTx0, aliceTx0, aliceTx1}, selectAll(context.Background(), mp))
+
+	require.NoError(t, mp.Remove(aliceTx0))
+	require.ErrorIs(t, mp.Remove(aliceTx0), mempool.ErrTxNotFound)
+	require.Equal(t, 3, mp.CountTx())
+
+	_, found := mp.PendingTx(alice.Bytes(), 0)
+	require.False(t, found)
+	pending, found := mp.PendingTx(alice.Bytes(), 1)
+	require.True(t, found)
+	require.Equal(t, aliceTx1, pending)
+}
+
+func TestTacMempoolReplaceByFee(t *testing.T) {
+	mp := NewTacMempool(MempoolConfig{PriceBump: 10}, nil, MakeEncodingConfig().TxConfig.TxEncoder())
+	ctx := newTestMempoolContext(t)
+
+	alice := common.HexToAddress("0xa11ce00000000000000000000000000000000000")
+	stuckTx := newTestEthTx(t, alice, 0)
+	require.NoError(t, mp.Insert(ctx.WithPriority(100), stuckTx))
+
+	// a replacement must bump the priority by at least 10%
+	require.Error(t, mp.Insert(ctx.WithPriority(105), newTestEthTx(t, alice, 0)))
+	pending, found := mp.PendingTx(alice.Bytes(), 0)
+	require.True(t, found)
+	require.Equal(t, stuckTx, pending)
+
+	replacementTx := newTestEthTx(t, alice, 0)
+	require.NoError(t, mp.Insert(ctx.WithPriority(110), replacementTx))
+	require.Equal(t, 1, mp.CountTx())
+
+	pending, found = mp.PendingTx(alice.Bytes(), 0)
+	require.True(t, found)
+	require.Equal(t, replacementTx, pending)
+	require.Equal(t, []sdk.Tx{replacementTx}, selectAll(context.Background(), mp))
+}
+
+func TestTacMempoolNonceGaps(t *testing.T) {
+	mp := NewTacMempool(DefaultTacConfig().Mempool, nil, MakeEncodingConfig().TxConfig.TxEncoder())
+	ctx := newTestMempoolContext(t)
+
+	alice := common.HexToAddress("0xa11ce00000000000000000000000000000000000")
+	bob := common.HexToAddress("0xb0b0000000000000000000000000000000000000")
+
+	aliceTx0 := newTestEthTx(t, alice, 0)
+	aliceTx2 := newTestEthTx(t, alice, 2)
+	bobTx0 := newTestEthTx(t, bob, 0)
+
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), aliceTx0))
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), aliceTx2))
+	require.NoError(t, mp.Insert(ctx.WithPriority(5), bobTx0))
+
+	// alice's nonce 1 is missing, so her nonce 2 stays in the pool but is not selected
+	require.Equal(t, []sdk.Tx{aliceTx0, bobTx0}, selectAll(context.Background(), mp))
+	require.Equal(t, 3, mp.CountTx())
+
+	// once the gap is filled, all of alice's txs are selected in nonce order
+	aliceTx1 := newTestEthTx(t, alice, 1)
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), aliceTx1))
+	require.Equal(t, []sdk.Tx{aliceTx0, aliceTx1, aliceTx2, bobTx0}, selectAll(context.Background(), mp))
+}
+
+func TestTacMempoolSenderLimits(t *testing.T) {
+	mp := NewTacMempool(MempoolConfig{MaxTxsPerSender: 2, MaxBytesPerSender: 250, PriceBump: 10}, nil, MakeEncodingConfig().TxConfig.TxEncoder())
+	ctx := newTestMempoolContext(t).WithPriority(10).WithTxBytes(make([]byte, 100))
+
+	alice := common.HexToAddress("0xa11ce00000000000000000000000000000000000")
+	bob := common.HexToAddress("0xb0b0000000000000000000000000000000000000")
+
+	require.NoError(t, mp.Insert(ctx, newTestEthTx(t, alice, 0)))
+	require.NoError(t, mp.Insert(ctx, newTestEthTx(t, alice, 1)))
+	require.ErrorIs(t, mp.Insert(ctx, newTestEthTx(t, alice, 2)), ErrSenderTxLimit)
+
+	// a replacement does not count as another pending tx, but its size does
+	require.NoError(t, mp.Insert(ctx.WithPriority(20).WithTxBytes(make([]byte, 150)), newTestEthTx(t, alice, 1)))
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(30).WithTxBytes(make([]byte, 151)), newTestEthTx(t, alice, 1)), ErrSenderBytesLimit)
+
+	// other senders have their own limits
+	require.NoError(t, mp.Insert(ctx, newTestEthTx(t, bob, 0)))
+	require.Equal(t, 3, mp.CountTx())
+}
+
+func TestTacMempoolEviction(t *testing.T) {
+	mp := NewTacMempool(MempoolConfig{MaxTxs: 3, MaxBytes: 1000, PriceBump: 10}, nil, MakeEncodingConfig().TxConfig.TxEncoder())
+	ctx := newTestMempoolContext(t).WithTxBytes(make([]byte, 100))
+
+	alice := common.HexToAddress("0xa11ce00000000000000000000000000000000000")
+	bob := common.HexToAddress("0xb0b0000000000000000000000000000000000000")
+	carol := common.HexToAddress("0xca201000000000000000000000000000000000000")
+
+	aliceTx0 := newTestEthTx(t, alice, 0)
+	aliceTx1 := newTestEthTx(t, alice, 1)
+	bobTx0 := newTestEthTx(t, bob, 0)
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), aliceTx0))
+	require.NoError(t, mp.Insert(ctx.WithPriority(50), aliceTx1))
+	require.NoError(t, mp.Insert(ctx.WithPriority(30), bobTx0))
+
+	// a replacement of alice's last tx makes room by evicting bob's tx, which is
+	// kept since the replacement does not bump the priority enough
+	require.Error(t, mp.Insert(ctx.WithPriority(54).WithTxBytes(make([]byte, 900)), newTestEthTx(t, alice, 1)))
+	require.Equal(t, []sdk.Tx{bobTx0, aliceTx0, aliceTx1}, selectAll(context.Background(), mp))
+	_, found := mp.PendingTx(bob.Bytes(), 0)
+	require.True(t, found)
+
+	// the mempool is full and carol does not pay more than any last tx of a sender
+	require.ErrorIs(t, mp.Insert(ctx.WithPriority(30), newTestEthTx(t, carol, 0)), ErrMempoolFull)
+
+	// alice's nonce 0 has the lowest priority, but evicting it would leave a
+	// nonce gap, so bob's tx is evicted instead
+	carolTx0 := newTestEthTx(t, carol, 0)
+	require.NoError(t, mp.Insert(ctx.WithPriority(40), carolTx0))
+	require.Equal(t, []sdk.Tx{carolTx0, aliceTx0, aliceTx1}, selectAll(context.Background(), mp))
+	_, found = mp.PendingTx(bob.Bytes(), 0)
+	require.False(t, found)
+
+	// the byte limit evicts as many txs as needed, carol's and then alice's last
+	bigTx := newTestEthTx(t, bob, 0)
+	require.NoError(t, mp.Insert(ctx.WithPriority(60).WithTxBytes(make([]byte, 900)), bigTx))
+	require.Equal(t, []sdk.Tx{bigTx, aliceTx0}, selectAll(context.Background(), mp))
+}
+
+func TestMempoolRecheckDecorator(t *testing.T) {
+	mp := NewTacMempool(MempoolConfig{PriceBump: 10}, nil, MakeEncodingConfig().TxConfig.TxEncoder())
+
+	alice := common.HexToAddress("0xa11ce00000000000000000000000000000000000")
+	tx := newTestEthTx(t, alice, 0)
+	ctx := withTestTxBytes(t, newTestMempoolContext(t), tx).WithPriority(10)
+	require.NoError(t, mp.Insert(ctx, tx))
+
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	decorator := NewMempoolRecheckDecorator(mp)
+
+	_, err := decorator.AnteHandle(ctx.WithIsReCheckTx(true), tx, false, next)
+	require.NoError(t, err)
+
+	// a tx with other bytes was replaced
+	_, err = decorator.AnteHandle(ctx.WithIsReCheckTx(true).WithTxBytes([]byte("alice tx 0 v2")), tx, false, next)
+	require.ErrorIs(t, err, ErrTxEvicted)
+
+	require.NoError(t, mp.Remove(tx))
+	_, err = decorator.AnteHandle(ctx.WithIsReCheckTx(true), tx, false, next)
+	require.ErrorIs(t, err, ErrTxEvicted)
+
+	// CheckTx is not affected
+	_, err = decorator.AnteHandle(ctx.WithIsCheckTx(true), tx, false, next)
+	require.NoError(t, err)
+}
+
+func TestMempoolRecheckAfterReplacement(t *testing.T) {
+	mp := NewTacMempool(MempoolConfig{PriceBump: 10}, nil, MakeEncodingConfig().TxConfig.TxEncoder())
+	ctx := newTestMempoolContext(t)
+
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	decorator := NewMempoolRecheckDecorator(mp)
+
+	alice := common.HexToAddress("0xa11ce00000000000000000000000000000000000")
+	stuckTx := newTestEthTxWithGasPrice(t, alice, 0, big.NewInt(10))
+	replacementTx := newTestEthTxWithGasPrice(t, alice, 0, big.NewInt(20))
+	stuckCtx := withTestTxBytes(t, ctx, stuckTx).WithPriority(10)
+	replacementCtx := withTestTxBytes(t, ctx, replacementTx).WithPriority(20)
+	require.NoError(t, mp.Insert(stuckCtx, stuckTx))
+	require.NoError(t, mp.Insert(replacementCtx, replacementTx))
+
+	// the replaced tx fails ReCheckTx and baseapp removes it from the mempool,
+	// which must not remove its replacement
+	_, err := decorator.AnteHandle(stuckCtx.WithIsReCheckTx(true), stuckTx, false, next)
+	require.ErrorIs(t, err, ErrTxEvicted)
+	require.ErrorIs(t, mp.Remove(stuckTx), mempool.ErrTxNotFound)
+	require.Equal(t, 1, mp.CountTx())
+
+	_, err = decorator.AnteHandle(replacementCtx.WithIsReCheckTx(true), replacementTx, false, next)
+	require.NoError(t, err)
+	pending, found := mp.PendingTx(alice.Bytes(), 0)
+	require.True(t, found)
+	require.Equal(t, replacementTx, pending)
+
+	require.NoError(t, mp.Remove(replacementTx))
+	require.Equal(t, 0, mp.CountTx())
+}
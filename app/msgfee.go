@@ -0,0 +1,158 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"context"
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
+)
+
+var _ sdk.AnteDecorator = MsgFeeDecorator{}
+
+const (
+	// EventTypeMsgFee is emitted with the fees a tx owes for its message types
+	EventTypeMsgFee = "msg_fee"
+
+	AttributeKeyMinFee        = "min_fee"
+	AttributeKeyGasMultiplier = "gas_multiplier"
+)
+
+// MsgFeeKeeper returns the minimum fee and the gas multiplier of messages.
+type MsgFeeKeeper interface {
+	MsgFees(ctx context.Context, msgs []sdk.Msg) (sdk.Coins, sdkmath.LegacyDec, error)
+}
+
+// FeeMarketParamsKeeper returns the gas prices of the fee market, set by
+// governance.
+type FeeMarketParamsKeeper interface {
+	GetParams(ctx sdk.Context) feemarkettypes.Params
+	GetBaseFeeEnabled(ctx sdk.Context) bool
+}
+
+// MsgFeeDecorator enforces the fee schedule of the message types defined by
+// governance. The fees of a tx must include the minimum fees of its messages,
+// and the remaining fees must pay the gas of the tx multiplied by the highest
+// gas multiplier of its messages, if above 1, at the gas price of the fee
+// market: the highest of its minimum gas price and its base fee, which the
+// Ethereum txs pay too. Both are enforced in every mode. In CheckTx, the
+// multiplied gas must also pay the minimum gas prices of the validator.
+//
+// The fees paid in a denom accepted by the feeabs module are converted to the
+// base denom first, if a FeeAbsKeeper is set. The surcharge is emitted as an
+// event, including when simulating, so that clients can estimate the fees.
+type MsgFeeDecorator struct {
+	msgFeeKeeper    MsgFeeKeeper
+	feeAbsKeeper    FeeAbsKeeper
+	feeMarketKeeper FeeMarketParamsKeeper
+}
+
+// NewMsgFeeDecorator creates a new MsgFeeDecorator. The FeeAbsKeeper can be
+// nil if the fees are deducted in the base denom only.
+func NewMsgFeeDecorator(mfk MsgFeeKeeper, fak FeeAbsKeeper, fmk FeeMarketParamsKeeper) MsgFeeDecorator {
+	return MsgFeeDecorator{
+		msgFeeKeeper:    mfk,
+		feeAbsKeeper:    fak,
+		feeMarketKeeper: fmk,
+	}
+}
+
+// AnteHandle rejects the txs not paying the fees due for their message types.
+// It must run before the fees are deducted.
+func (mfd MsgFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(errortypes.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	minFee, multiplier, err := mfd.msgFeeKeeper.MsgFees(ctx, tx.GetMsgs())
+	if err != nil {
+		return ctx, err
+	}
+	if minFee.IsZero() && multiplier.LTE(sdkmath.LegacyOneDec()) {
+		return next(ctx, tx, simulate)
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		EventTypeMsgFee,
+		sdk.NewAttribute(AttributeKeyMinFee, minFee.String()),
+		sdk.NewAttribute(AttributeKeyGasMultiplier, multiplier.String()),
+	))
+	if simulate {
+		return next(ctx, tx, simulate)
+	}
+
+	fee, err := mfd.baseFee(ctx, feeTx.GetFee())
+	if err != nil {
+		return ctx, err
+	}
+	if !fee.IsAllGTE(minFee) {
+		return ctx, errorsmod.Wrapf(errortypes.ErrInsufficientFee, "insufficient fees; got: %s required: %s by the message fees", fee, minFee)
+	}
+
+	gas := multipliedGas(feeTx.GetGas(), multiplier)
+	gasFee := fee.Sub(minFee...)
+	if multiplier.GT(sdkmath.LegacyOneDec()) {
+		required := mfd.gasPrice(ctx).MulInt(sdkmath.NewIntFromUint64(gas)).Ceil().TruncateInt()
+		if gasFee.AmountOf(BaseDenom).LT(required) {
+			return ctx, errorsmod.Wrapf(errortypes.ErrInsufficientFee, "insufficient fees; got: %s required: %s%s beyond the message fees for %d gas", gasFee, required, BaseDenom, gas)
+		}
+	}
+	if ctx.IsCheckTx() {
+		if err := checkMinGasPrices(ctx, gasFee, gas); err != nil {
+			return ctx, errorsmod.Wrapf(err, "%s of the fees beyond the message fees for %d gas", gasFee, gas)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// baseFee converts the fees paid in a single denom accepted by the feeabs
+// module to the base denom, like the FeeAbsDecorator. The other fees are
+// returned as is.
+func (mfd MsgFeeDecorator) baseFee(ctx sdk.Context, fee sdk.Coins) (sdk.Coins, error) {
+	if mfd.feeAbsKeeper == nil || len(fee) != 1 || fee[0].Denom == BaseDenom {
+		return fee, nil
+	}
+	rate, ok, err := mfd.feeAbsKeeper.ConversionRate(ctx, fee[0].Denom)
+	if err != nil {
+		return nil, errorsmod.Wrapf(errortypes.ErrInvalidRequest, "cannot pay fees in %s: %s", fee[0].Denom, err)
+	}
+	if !ok {
+		return fee, nil
+	}
+	return sdk.NewCoins(sdk.NewCoin(BaseDenom, rate.MulInt(fee[0].Amount).TruncateInt())), nil
+}
+
+// gasPrice returns the gas price of the fee market in the base denom: the
+// highest of its minimum gas price and its base fee, if enabled.
+func (mfd MsgFeeDecorator) gasPrice(ctx sdk.Context) sdkmath.LegacyDec {
+	params := mfd.feeMarketKeeper.GetParams(ctx)
+	price := params.MinGasPrice
+	if price.IsNil() {
+		price = sdkmath.LegacyZeroDec()
+	}
+	if mfd.feeMarketKeeper.GetBaseFeeEnabled(ctx) && !params.BaseFee.IsNil() {
+		price = sdkmath.LegacyMaxDec(price, sdkmath.LegacyNewDecFromInt(params.BaseFee))
+	}
+	return price
+}
+
+// multipliedGas returns the gas multiplied by multiplier, rounded up and
+// capped to the maximum int64 checkMinGasPrices accepts.
+func multipliedGas(gas uint64, multiplier sdkmath.LegacyDec) uint64 {
+	multiplied := multiplier.MulInt(sdkmath.NewIntFromUint64(gas)).Ceil().TruncateInt()
+	if !multiplied.IsInt64() {
+		return math.MaxInt64
+	}
+	return multiplied.Uint64()
+}
@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	feemarkettypes "github.com/evmos/ethermint/x/feemarket/types"
+)
+
+// testMsgFeeKeeper returns the same fees for all messages.
+type testMsgFeeKeeper struct {
+	minFee     sdk.Coins
+	multiplier sdkmath.LegacyDec
+}
+
+func (k testMsgFeeKeeper) MsgFees(_ context.Context, _ []sdk.Msg) (sdk.Coins, sdkmath.LegacyDec, error) {
+	return k.minFee, k.multiplier, nil
+}
+
+// testFeeMarketKeeper returns the fee market params, with the base fee enabled
+// if set.
+type testFeeMarketKeeper struct {
+	params feemarkettypes.Params
+}
+
+func (k testFeeMarketKeeper) GetParams(_ sdk.Context) feemarkettypes.Params {
+	return k.params
+}
+
+func (k testFeeMarketKeeper) GetBaseFeeEnabled(_ sdk.Context) bool {
+	return !k.params.NoBaseFee
+}
+
+func TestMsgFeeDecorator(t *testing.T) {
+	ibcDenom := "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+	feeAbsKeeper := testFeeAbsKeeper{ibcDenom: sdkmath.LegacyNewDec(10)}
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	testCases := []struct {
+		name       string
+		minFee     int64
+		multiplier int64
+		fee        sdk.Coin
+		baseFee    int64
+		checkTx    bool
+		simulate   bool
+		err        error
+	}{
+		{name: "no message fees", multiplier: 1, fee: sdk.NewInt64Coin(BaseDenom, 0), checkTx: true},
+		{name: "minimum fee", minFee: 1_000, multiplier: 1, fee: sdk.NewInt64Coin(BaseDenom, 1_000)},
+		{name: "insufficient minimum fee", minFee: 1_000, multiplier: 1, fee: sdk.NewInt64Coin(BaseDenom, 999), err: errortypes.ErrInsufficientFee},
+		{name: "minimum fee on top of gas prices", minFee: 1_000, multiplier: 1, fee: sdk.NewInt64Coin(BaseDenom, 1_100), checkTx: true},
+		{name: "minimum fee without gas prices", minFee: 1_000, multiplier: 1, fee: sdk.NewInt64Coin(BaseDenom, 1_099), checkTx: true, err: errortypes.ErrInsufficientFee},
+		{name: "gas multiplier", multiplier: 3, fee: sdk.NewInt64Coin(BaseDenom, 300), checkTx: true},
+		{name: "insufficient gas multiplier", multiplier: 3, fee: sdk.NewInt64Coin(BaseDenom, 299), checkTx: true, err: errortypes.ErrInsufficientFee},
+		{name: "gas multiplier in DeliverTx", multiplier: 3, fee: sdk.NewInt64Coin(BaseDenom, 300)},
+		{name: "insufficient gas multiplier in DeliverTx", multiplier: 3, fee: sdk.NewInt64Coin(BaseDenom, 299), err: errortypes.ErrInsufficientFee},
+		{name: "gas multiplier at the base fee", multiplier: 3, fee: sdk.NewInt64Coin(BaseDenom, 600), baseFee: 2},
+		{name: "insufficient gas multiplier at the base fee", multiplier: 3, fee: sdk.NewInt64Coin(BaseDenom, 599), baseFee: 2, err: errortypes.ErrInsufficientFee},
+		{name: "converted fee", minFee: 1_000, multiplier: 2, fee: sdk.NewInt64Coin(ibcDenom, 120), checkTx: true},
+		{name: "insufficient converted fee", minFee: 1_000, multiplier: 2, fee: sdk.NewInt64Coin(ibcDenom, 119), checkTx: true, err: errortypes.ErrInsufficientFee},
+		{name: "simulation", minFee: 1_000, multiplier: 2, fee: sdk.NewInt64Coin(BaseDenom, 0), checkTx: true, simulate: true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := newTestMempoolContext(t).
+				WithIsCheckTx(tc.checkTx).
+				WithMinGasPrices(sdk.NewDecCoins(sdk.NewInt64DecCoin(BaseDenom, 1)))
+			msgFeeKeeper := testMsgFeeKeeper{
+				minFee:     sdk.NewCoins(sdk.NewInt64Coin(BaseDenom, tc.minFee)),
+				multiplier: sdkmath.LegacyNewDec(tc.multiplier),
+			}
+			// the validator and governance set the same minimum gas price
+			params := feemarkettypes.DefaultParams()
+			params.MinGasPrice = sdkmath.LegacyOneDec()
+			params.BaseFee = sdkmath.NewInt(tc.baseFee)
+			params.NoBaseFee = tc.baseFee == 0
+			feeMarketKeeper := testFeeMarketKeeper{params: params}
+			tx := newTestFeeAbsTx(t, sdk.NewCoins(tc.fee), 100)
+
+			_, err := NewMsgFeeDecorator(msgFeeKeeper, feeAbsKeeper, feeMarketKeeper).AnteHandle(ctx, tx, tc.simulate, next)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			// the surcharge is emitted, including when simulating
+			events := ctx.EventManager().Events()
+			if tc.minFee == 0 && tc.multiplier == 1 {
+				require.Empty(t, events)
+				return
+			}
+			require.Len(t, events, 1)
+			require.Equal(t, EventTypeMsgFee, events[0].Type)
+			require.Equal(t, msgFeeKeeper.minFee.String(), events[0].Attributes[0].Value)
+			require.Equal(t, msgFeeKeeper.multiplier.String(), events[0].Attributes[1].Value)
+		})
+	}
+}
@@ -0,0 +1,42 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ sdk.AnteDecorator = MsgFilterDecorator{}
+
+// MsgFilter rejects txs carrying disabled message types, and returns the
+// minimum fees of the message types.
+type MsgFilter interface {
+	MsgFeeKeeper
+	CheckMsgs(ctx context.Context, msgs []sdk.Msg) error
+}
+
+// MsgFilterDecorator rejects the txs carrying message types disabled by
+// governance, including nested ones. It runs after SetUpContext, so that its
+// reads of the params are charged to the tx.
+type MsgFilterDecorator struct {
+	msgFilter MsgFilter
+}
+
+// NewMsgFilterDecorator creates a new MsgFilterDecorator.
+func NewMsgFilterDecorator(mf MsgFilter) MsgFilterDecorator {
+	return MsgFilterDecorator{
+		msgFilter: mf,
+	}
+}
+
+// AnteHandle rejects the tx if any of its messages is disabled.
+func (mfd MsgFilterDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if err := mfd.msgFilter.CheckMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,43 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	msgfiltertypes "github.com/Asphere-xyz/tacchain/x/msgfilter/types"
+)
+
+func TestMsgFilterDecorator(t *testing.T) {
+	app := newTestEIP712App(t)
+	ctx := app.NewContext(false).WithBlockHeight(1)
+	vesting := vestingtypes.NewMsgCreateVestingAccount(testSender.Bytes(), testGranter, sdk.NewCoins(sdk.NewInt64Coin(BaseDenom, 1)), 1, false)
+
+	// the cosmos and legacy EIP-712 txs
+	for _, extension := range []string{"", "/ethermint.types.v1.ExtensionOptionsWeb3Tx"} {
+		builder := app.TxConfig().NewTxBuilder().(authtx.ExtensionOptionsTxBuilder)
+		require.NoError(t, builder.SetMsgs(vesting))
+		builder.SetGasLimit(200_000)
+		if extension != "" {
+			builder.SetExtensionOptions(&codectypes.Any{TypeUrl: extension})
+		}
+
+		// the disabled messages are rejected once the gas meter of the tx is
+		// set up, so that reading the params is charged to the tx
+		newCtx, err := app.AnteHandler()(ctx, builder.GetTx(), false)
+		require.ErrorIs(t, err, msgfiltertypes.ErrDisabledMsg)
+		require.Equal(t, storetypes.Gas(200_000), newCtx.GasMeter().Limit())
+		require.NotZero(t, newCtx.GasMeter().GasConsumed())
+	}
+}
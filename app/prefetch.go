@@ -0,0 +1,281 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	metrics "github.com/hashicorp/go-metrics"
+
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// PrefetchEVMKeeper defines the EVM keeper reads used to prefetch the state of
+// a proposal.
+type PrefetchEVMKeeper interface {
+	ChainID() *big.Int
+	GetParams(ctx sdk.Context) evmtypes.Params
+	GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account
+	GetCode(ctx sdk.Context, codeHash common.Hash) []byte
+	GetState(ctx sdk.Context, addr common.Address, key common.Hash) common.Hash
+}
+
+// Prefetcher loads the state read by the Ethereum txs of a proposal while the
+// proposal is being processed, so that block execution finds it in the IAVL
+// node caches. It loads the sender and recipient accounts, the recipient code
+// and the storage slots of the access lists, and adds the recovered senders to
+// the SigCache.
+//
+// The state is read from the last committed version through contexts of their
+// own, so the prefetcher never touches the state of the block being executed.
+type Prefetcher struct {
+	workers    int
+	txDecoder  sdk.TxDecoder
+	evmKeeper  PrefetchEVMKeeper
+	sigCache   *SigCache
+	newContext func() (sdk.Context, error)
+	logger     log.Logger
+
+	mu     sync.Mutex
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+// NewPrefetcher creates a Prefetcher reading the committed state through the
+// contexts returned by newContext. It returns nil, which disables prefetching,
+// if cfg is not enabled.
+func NewPrefetcher(
+	cfg PrefetchConfig,
+	txDecoder sdk.TxDecoder,
+	evmKeeper PrefetchEVMKeeper,
+	sigCache *SigCache,
+	newContext func() (sdk.Context, error),
+	logger log.Logger,
+) *Prefetcher {
+	if !cfg.Enabled {
+		return nil
+	}
+
+	return &Prefetcher{
+		workers:    cfg.Workers,
+		txDecoder:  txDecoder,
+		evmKeeper:  evmKeeper,
+		sigCache:   sigCache,
+		newContext: newContext,
+		logger:     logger.With("module", "prefetcher"),
+	}
+}
+
+// ProcessProposalHandler wraps next to start prefetching the state of a
+// proposal as soon as it's received. The prefetch is stopped if the proposal is
+// rejected.
+func (p *Prefetcher) ProcessProposalHandler(next sdk.ProcessProposalHandler) sdk.ProcessProposalHandler {
+	if p == nil {
+		return next
+	}
+
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		p.Start(req.Height, req.Time, req.Txs)
+
+		res, err := next(ctx, req)
+		if err != nil || res.Status != abci.ResponseProcessProposal_ACCEPT {
+			p.Stop()
+		}
+		return res, err
+	}
+}
+
+// Start stops any running prefetch and starts prefetching the state of the
+// txs of the block at height in the background.
+func (p *Prefetcher) Start(height int64, blockTime time.Time, txs [][]byte) {
+	if p == nil {
+		return
+	}
+
+	p.Stop()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	p.mu.Lock()
+	p.cancel, p.done = cancel, done
+	p.mu.Unlock()
+
+	go func() {
+		defer close(done)
+		p.prefetch(ctx, height, blockTime, txs)
+	}()
+}
+
+// Stop cancels the running prefetch, if any, and waits for it to return. It's
+// called once the block is executed, since the state is of no use afterwards.
+func (p *Prefetcher) Stop() {
+	if p == nil {
+		return
+	}
+
+	p.mu.Lock()
+	cancel, done := p.cancel, p.done
+	p.cancel, p.done = nil, nil
+	p.mu.Unlock()
+
+	if cancel != nil {
+		cancel()
+		<-done
+	}
+}
+
+// prefetch loads the state of txs using the configured number of workers.
+// Every worker reads through a context of its own, since the context stores and
+// gas meters are not meant to be shared between goroutines.
+func (p *Prefetcher) prefetch(ctx context.Context, height int64, blockTime time.Time, txs [][]byte) {
+	defer telemetry.MeasureSince(time.Now(), "tac", "prefetch", "duration")
+
+	// the committed state is of no use before the first block
+	sdkCtx, err := p.newContext()
+	if err != nil {
+		p.logger.Debug("skipping prefetch", "height", height, "err", err)
+		return
+	}
+	evmParams := p.evmKeeper.GetParams(sdkCtx)
+	ethCfg := evmParams.GetChainConfig().EthereumConfig(p.evmKeeper.ChainID())
+	signer := ethtypes.MakeSigner(ethCfg, big.NewInt(height), uint64(blockTime.Unix()))
+
+	var (
+		seen    sync.Map
+		loaded  [3]atomic.Int64
+		next    atomic.Int64
+		wg      sync.WaitGroup
+		workers = min(p.workers, len(txs))
+	)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			workerCtx, err := p.newContext()
+			if err != nil {
+				return
+			}
+			for ctx.Err() == nil {
+				i := int(next.Add(1)) - 1
+				if i >= len(txs) {
+					return
+				}
+				p.prefetchTx(ctx, workerCtx, signer, txs[i], &seen, &loaded)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for kind, n := range map[string]int64{
+		"account": loaded[0].Load(),
+		"code":    loaded[1].Load(),
+		"slot":    loaded[2].Load(),
+	} {
+		telemetry.IncrCounterWithLabels(
+			[]string{"tac", "prefetch", "loaded"},
+			float32(n),
+			[]metrics.Label{telemetry.NewLabel("kind", kind)},
+		)
+	}
+	if ctx.Err() != nil {
+		telemetry.IncrCounter(1, "tac", "prefetch", "aborted")
+	}
+}
+
+// prefetchTx loads the state read by the Ethereum txs in bz. Accounts and
+// slots already loaded by another tx of the block are skipped, as are txs that
+// cannot be decoded; those are rejected by ProcessProposal.
+func (p *Prefetcher) prefetchTx(
+	ctx context.Context,
+	sdkCtx sdk.Context,
+	signer ethtypes.Signer,
+	bz []byte,
+	seen *sync.Map,
+	loaded *[3]atomic.Int64,
+) {
+	tx, err := p.txDecoder(bz)
+	if err != nil {
+		return
+	}
+
+	loadAccount := func(addr common.Address) {
+		if _, ok := seen.LoadOrStore(addr, struct{}{}); ok {
+			return
+		}
+		loaded[0].Add(1)
+		if acct := p.evmKeeper.GetAccount(sdkCtx, addr); acct != nil && acct.IsContract() {
+			p.evmKeeper.GetCode(sdkCtx, common.BytesToHash(acct.CodeHash))
+			loaded[1].Add(1)
+		}
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		if ctx.Err() != nil {
+			return
+		}
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			continue
+		}
+
+		ethTx := msgEthTx.AsTransaction()
+		if sender, err := p.sigCache.recoverSender(signer, ethTx); err == nil {
+			loadAccount(sender)
+		}
+		if to := ethTx.To(); to != nil {
+			loadAccount(*to)
+		}
+		for _, tuple := range ethTx.AccessList() {
+			loadAccount(tuple.Address)
+			for _, key := range tuple.StorageKeys {
+				if _, ok := seen.LoadOrStore(prefetchSlot{tuple.Address, key}, struct{}{}); ok {
+					continue
+				}
+				p.evmKeeper.GetState(sdkCtx, tuple.Address, key)
+				loaded[2].Add(1)
+			}
+		}
+	}
+}
+
+// prefetchSlot identifies a storage slot loaded by the prefetcher.
+type prefetchSlot struct {
+	addr common.Address
+	key  common.Hash
+}
+
+// blockExecutionMetric measures the execution of a block from the start of the
+// PreBlocker, labelled by whether prefetching is enabled, to compare the
+// execution time of nodes with and without it.
+func blockExecutionMetric(start time.Time, prefetch bool) {
+	if start.IsZero() || !telemetry.IsTelemetryEnabled() {
+		return
+	}
+
+	label := "disabled"
+	if prefetch {
+		label = "enabled"
+	}
+	metrics.MeasureSinceWithLabels(
+		[]string{"tac", "block", "execution"},
+		start.UTC(),
+		[]metrics.Label{telemetry.NewLabel("prefetch", label)},
+	)
+}
@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// testPrefetchEVMKeeper records the state read by the Prefetcher.
+type testPrefetchEVMKeeper struct {
+	testEVMKeeper
+
+	contracts map[common.Address]common.Hash
+
+	mu       sync.Mutex
+	accounts []common.Address
+	codes    []common.Hash
+	slots    []common.Hash
+}
+
+func (k *testPrefetchEVMKeeper) ChainID() *big.Int {
+	return big.NewInt(2390)
+}
+
+func (k *testPrefetchEVMKeeper) GetAccount(_ sdk.Context, addr common.Address) *statedb.Account {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.accounts = append(k.accounts, addr)
+
+	acct := statedb.NewEmptyAccount()
+	if codeHash, ok := k.contracts[addr]; ok {
+		acct.CodeHash = codeHash.Bytes()
+	}
+	return acct
+}
+
+func (k *testPrefetchEVMKeeper) GetCode(_ sdk.Context, codeHash common.Hash) []byte {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.codes = append(k.codes, codeHash)
+	return nil
+}
+
+func (k *testPrefetchEVMKeeper) GetState(_ sdk.Context, _ common.Address, key common.Hash) common.Hash {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	k.slots = append(k.slots, key)
+	return common.Hash{}
+}
+
+func TestPrefetcher(t *testing.T) {
+	chainID := big.NewInt(2390)
+	txConfig := newTestTxConfig()
+	ctx := newTestMempoolContext(t)
+
+	contract := common.HexToAddress("0x2000000000000000000000000000000000000002")
+	codeHash := common.HexToHash("0xc0de")
+	slot1, slot2 := common.HexToHash("0x01"), common.HexToHash("0x02")
+	keeper := &testPrefetchEVMKeeper{contracts: map[common.Address]common.Hash{contract: codeHash}}
+
+	// two calls of the same contract by one sender, sharing a storage slot
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+	sender := crypto.PubkeyToAddress(key.PublicKey)
+	var txs [][]byte
+	for nonce, slot := range []common.Hash{slot1, slot2} {
+		ethTx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(chainID), &ethtypes.DynamicFeeTx{
+			ChainID:    chainID,
+			Nonce:      uint64(nonce),
+			To:         &contract,
+			Gas:        50_000,
+			GasFeeCap:  big.NewInt(1),
+			GasTipCap:  big.NewInt(1),
+			AccessList: ethtypes.AccessList{{Address: contract, StorageKeys: []common.Hash{slot1, slot}}},
+		})
+		require.NoError(t, err)
+
+		msg := &evmtypes.MsgEthereumTx{}
+		require.NoError(t, msg.FromEthereumTx(ethTx))
+		tx, err := msg.BuildTx(txConfig.NewTxBuilder(), BaseDenom)
+		require.NoError(t, err)
+		bz, err := txConfig.TxEncoder()(tx)
+		require.NoError(t, err)
+		txs = append(txs, bz)
+	}
+	// txs that cannot be decoded are skipped
+	txs = append(txs, []byte("not a tx"))
+
+	sigCache := NewSigCache(10)
+	prefetcher := NewPrefetcher(
+		PrefetchConfig{Enabled: true, Workers: 2},
+		txConfig.TxDecoder(),
+		keeper,
+		sigCache,
+		func() (sdk.Context, error) { return ctx, nil },
+		log.NewNopLogger(),
+	)
+	prefetcher.prefetch(context.Background(), 1, time.Now(), txs)
+
+	require.ElementsMatch(t, []common.Address{sender, contract}, keeper.accounts)
+	require.Equal(t, []common.Hash{codeHash}, keeper.codes)
+	require.ElementsMatch(t, []common.Hash{slot1, slot2}, keeper.slots)
+	require.Equal(t, 2, sigCache.Len())
+
+	// Start runs in the background until stopped
+	prefetcher.Start(1, time.Now(), txs)
+	prefetcher.Stop()
+	prefetcher.Stop()
+
+	require.Nil(t, NewPrefetcher(PrefetchConfig{}, nil, nil, nil, nil, nil))
+}
@@ -0,0 +1,462 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/ethereum/go-ethereum/core"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	metrics "github.com/hashicorp/go-metrics"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+
+	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	etherminttypes "github.com/evmos/ethermint/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// Lane identifies the share of block space a transaction competes for.
+type Lane int
+
+const (
+	// LaneEVM holds Ethereum transactions.
+	LaneEVM Lane = iota
+	// LaneCosmos holds plain Cosmos SDK and CosmWasm transactions.
+	LaneCosmos
+	// LaneIBC holds transactions made only of IBC relayer messages.
+	LaneIBC
+
+	numLanes = iota
+)
+
+// String implements fmt.Stringer.
+func (l Lane) String() string {
+	switch l {
+	case LaneEVM:
+		return "evm"
+	case LaneCosmos:
+		return "cosmos"
+	case LaneIBC:
+		return "ibc"
+	default:
+		return "unknown"
+	}
+}
+
+// TxLane returns the lane of a transaction. Transactions carrying a
+// MsgEthereumTx belong to the EVM lane, transactions made only of IBC relayer
+// messages to the IBC lane and everything else to the Cosmos lane.
+func TxLane(tx sdk.Tx) Lane {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return LaneCosmos
+	}
+
+	relayerOnly := true
+	for _, msg := range msgs {
+		switch msg.(type) {
+		case *evmtypes.MsgEthereumTx:
+			return LaneEVM
+		case *ibcclienttypes.MsgUpdateClient,
+			*ibcchanneltypes.MsgRecvPacket,
+			*ibcchanneltypes.MsgAcknowledgement,
+			*ibcchanneltypes.MsgTimeout,
+			*ibcchanneltypes.MsgTimeoutOnClose:
+		default:
+			relayerOnly = false
+		}
+	}
+	if relayerOnly {
+		return LaneIBC
+	}
+	return LaneCosmos
+}
+
+// LaneStats holds the block space a lane used in the last prepared proposal.
+type LaneStats struct {
+	Limit   uint64
+	GasUsed uint64
+	NumTxs  int
+}
+
+// ProposalEVMKeeper defines the EVM keeper methods used to check Ethereum
+// transactions in proposals.
+type ProposalEVMKeeper interface {
+	GetParams(ctx sdk.Context) evmtypes.Params
+}
+
+// ProposalHandler prepares proposals from the app-side mempool, splitting the
+// block gas between the EVM, Cosmos and IBC lanes so that a flood of
+// transactions in one lane can not starve the others, and checks the
+// proposals of other validators.
+type ProposalHandler struct {
+	mempool         mempool.Mempool
+	txVerifier      baseapp.ProposalTxVerifier
+	evmKeeper       ProposalEVMKeeper
+	unprotectedTxs  UnprotectedTxKeeper
+	valStore        baseapp.ValidatorStore
+	signerExtractor mempool.SignerExtractionAdapter
+	shares          [numLanes]uint64
+}
+
+// NewProposalHandler returns a ProposalHandler for the given mempool and lane
+// configuration. The validator store is used to verify the vote extensions
+// injected into a proposal, and the unprotected tx keeper to accept the
+// allowlisted unprotected Ethereum txs.
+func NewProposalHandler(mp mempool.Mempool, txVerifier baseapp.ProposalTxVerifier, evmKeeper ProposalEVMKeeper, unprotectedTxs UnprotectedTxKeeper, valStore baseapp.ValidatorStore, cfg LanesConfig) *ProposalHandler {
+	h := &ProposalHandler{
+		mempool:         mp,
+		txVerifier:      txVerifier,
+		evmKeeper:       evmKeeper,
+		unprotectedTxs:  unprotectedTxs,
+		valStore:        valStore,
+		signerExtractor: NewEthSignerExtractionAdapter(),
+	}
+	h.shares[LaneEVM] = cfg.EVMShare
+	h.shares[LaneCosmos] = cfg.CosmosShare
+	h.shares[LaneIBC] = cfg.IBCShare
+	return h
+}
+
+// laneTx is a verified mempool transaction waiting for block space.
+type laneTx struct {
+	bz      []byte
+	gas     uint64
+	lane    Lane
+	signers []mempool.SignerData
+}
+
+// proposalBuilder tracks the transactions and block space of a proposal being
+// prepared.
+type proposalBuilder struct {
+	maxBytes   uint64
+	maxGas     uint64
+	totalBytes uint64
+	totalGas   uint64
+	lanes      [numLanes]LaneStats
+	txs        [][]byte
+	// sequences holds the last selected sequence of every signer
+	sequences map[string]uint64
+}
+
+// inSequence reports whether the transaction continues the sequence of every
+// signer that already has a transaction in the proposal.
+func (b *proposalBuilder) inSequence(signers []mempool.SignerData) bool {
+	for _, signer := range signers {
+		if seq, ok := b.sequences[signer.Signer.String()]; ok && seq+1 != signer.Sequence {
+			return false
+		}
+	}
+	return true
+}
+
+// fits reports whether the transaction fits into the remaining block space,
+// and into the share of its lane if laneLimited is set.
+func (b *proposalBuilder) fits(ltx laneTx, laneLimited bool) bool {
+	if b.totalBytes+uint64(len(ltx.bz)) > b.maxBytes {
+		return false
+	}
+	if b.maxGas == 0 {
+		return true
+	}
+	if b.totalGas+ltx.gas > b.maxGas {
+		return false
+	}
+	return !laneLimited || b.lanes[ltx.lane].GasUsed+ltx.gas <= b.lanes[ltx.lane].Limit
+}
+
+func (b *proposalBuilder) add(ltx laneTx) {
+	b.txs = append(b.txs, ltx.bz)
+	b.totalBytes += uint64(len(ltx.bz))
+	b.totalGas += ltx.gas
+	b.lanes[ltx.lane].GasUsed += ltx.gas
+	b.lanes[ltx.lane].NumTxs++
+	for _, signer := range ltx.signers {
+		b.sequences[signer.Signer.String()] = signer.Sequence
+	}
+}
+
+func (b *proposalBuilder) full() bool {
+	return b.totalBytes >= b.maxBytes || (b.maxGas > 0 && b.totalGas >= b.maxGas)
+}
+
+// PrepareProposalHandler returns a PrepareProposal handler that selects
+// mempool transactions in priority order in two passes. The first pass only
+// admits a transaction if its lane stays within its share of the block gas.
+// The second pass hands the gas left over by the other lanes, and by the
+// unreserved share, to the transactions held back in the first pass.
+//
+// A signer with a held back transaction gets all its later transactions held
+// back as well, so that the proposal keeps the sequence order of every signer.
+//
+// Once vote extensions are enabled, the extended commit of the previous height
+// is injected as the first transaction of the proposal, so that the oracle
+// prices can be aggregated in the PreBlocker.
+func (h *ProposalHandler) PrepareProposalHandler() sdk.PrepareProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestPrepareProposal) (*abci.ResponsePrepareProposal, error) {
+		b := &proposalBuilder{
+			maxBytes:  uint64(req.MaxTxBytes),
+			sequences: make(map[string]uint64),
+		}
+		if voteExtensionsInjected(ctx, req.Height) {
+			bz, err := req.LocalLastCommit.Marshal()
+			if err != nil {
+				return nil, fmt.Errorf("failed to marshal extended commit: %w", err)
+			}
+			b.txs = append(b.txs, bz)
+			b.totalBytes += uint64(len(bz))
+		}
+		if block := ctx.ConsensusParams().Block; block != nil && block.MaxGas > 0 {
+			b.maxGas = uint64(block.MaxGas)
+		}
+		for lane := range b.lanes {
+			b.lanes[lane].Limit = b.maxGas * h.shares[lane] / 100
+		}
+
+		var heldBack []laneTx
+		heldBackSigners := make(map[string]struct{})
+		isHeldBack := func(signers []mempool.SignerData) bool {
+			for _, signer := range signers {
+				if _, ok := heldBackSigners[signer.Signer.String()]; ok {
+					return true
+				}
+			}
+			return false
+		}
+		holdBack := func(signers []mempool.SignerData) {
+			for _, signer := range signers {
+				heldBackSigners[signer.Signer.String()] = struct{}{}
+			}
+		}
+
+		for it := h.mempool.Select(ctx, req.Txs); it != nil && !b.full(); it = it.Next() {
+			memTx := it.Tx()
+			signers, err := h.signerExtractor.GetSigners(memTx)
+			if err != nil {
+				return nil, err
+			}
+			if !b.inSequence(signers) {
+				continue
+			}
+
+			// NOTE: transactions were verified in CheckTx already, but the state
+			// may have changed since then, so we verify them again.
+			bz, err := h.txVerifier.PrepareProposalVerifyTx(memTx)
+			if err != nil {
+				if err := h.mempool.Remove(memTx); err != nil && !errors.Is(err, mempool.ErrTxNotFound) {
+					return nil, err
+				}
+				continue
+			}
+
+			ltx := laneTx{bz: bz, lane: TxLane(memTx), signers: signers}
+			if gasTx, ok := memTx.(baseapp.GasTx); ok {
+				ltx.gas = gasTx.GetGas()
+			}
+
+			if isHeldBack(signers) || !b.fits(ltx, true) {
+				heldBack = append(heldBack, ltx)
+				holdBack(signers)
+				continue
+			}
+			b.add(ltx)
+		}
+
+		// skipped holds the signers with a held back transaction that did not
+		// make it into the proposal
+		skipped := make(map[string]struct{})
+		for _, ltx := range heldBack {
+			if b.full() {
+				break
+			}
+			fits := b.inSequence(ltx.signers) && b.fits(ltx, false)
+			for _, signer := range ltx.signers {
+				if _, ok := skipped[signer.Signer.String()]; ok {
+					fits = false
+				}
+			}
+			if !fits {
+				for _, signer := range ltx.signers {
+					skipped[signer.Signer.String()] = struct{}{}
+				}
+				continue
+			}
+			b.add(ltx)
+		}
+
+		h.exportLaneStats(ctx, b.lanes)
+
+		return &abci.ResponsePrepareProposal{Txs: b.txs}, nil
+	}
+}
+
+// ProcessProposalHandler returns a ProcessProposal handler that rejects a
+// proposal unless every transaction in it
+//
+// 1) decodes,
+// 2) passes the stateless Ethereum checks of checkEthTx, if it is an Ethereum
+// transaction,
+// 3) fits into the block max_gas together with the transactions before it,
+// summing the gas limits of Cosmos and Ethereum transactions alike, and
+// 4) passes the AnteHandler.
+//
+// Once vote extensions are enabled, the first transaction must be the extended
+// commit of the previous height, with vote extensions signed by validators
+// holding more than 2/3 of the voting power.
+//
+// The reason of a rejection is logged.
+func (h *ProposalHandler) ProcessProposalHandler() sdk.ProcessProposalHandler {
+	return func(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		var maxBlockGas uint64
+		if block := ctx.ConsensusParams().Block; block != nil && block.MaxGas > 0 {
+			maxBlockGas = uint64(block.MaxGas)
+		}
+
+		first := 0
+		if voteExtensionsInjected(ctx, req.Height) {
+			if len(req.Txs) == 0 {
+				return h.rejectProposal(ctx, req, 0, errors.New("missing extended commit"))
+			}
+			var extCommit abci.ExtendedCommitInfo
+			if err := extCommit.Unmarshal(req.Txs[0]); err != nil {
+				return h.rejectProposal(ctx, req, 0, fmt.Errorf("failed to decode extended commit: %w", err))
+			}
+			if err := baseapp.ValidateVoteExtensions(ctx, h.valStore, req.Height, ctx.ChainID(), extCommit); err != nil {
+				return h.rejectProposal(ctx, req, 0, fmt.Errorf("invalid vote extensions: %w", err))
+			}
+			first = 1
+		}
+
+		var blockGas uint64
+		for i := first; i < len(req.Txs); i++ {
+			bz := req.Txs[i]
+			tx, err := h.txVerifier.TxDecode(bz)
+			if err != nil {
+				return h.rejectProposal(ctx, req, i, fmt.Errorf("failed to decode tx: %w", err))
+			}
+
+			var txGas uint64
+			if gasTx, ok := tx.(baseapp.GasTx); ok {
+				txGas = gasTx.GetGas()
+			}
+			if TxLane(tx) == LaneEVM {
+				if txGas, err = h.checkEthTx(ctx, tx); err != nil {
+					return h.rejectProposal(ctx, req, i, err)
+				}
+			}
+
+			if maxBlockGas > 0 {
+				if txGas > maxBlockGas-blockGas {
+					return h.rejectProposal(ctx, req, i, fmt.Errorf("block gas exceeds max_gas %d", maxBlockGas))
+				}
+				blockGas += txGas
+			}
+
+			if _, err := h.txVerifier.ProcessProposalVerifyTx(bz); err != nil {
+				return h.rejectProposal(ctx, req, i, fmt.Errorf("failed to verify tx: %w", err))
+			}
+		}
+
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	}
+}
+
+// checkEthTx runs the stateless checks of an Ethereum transaction: it must
+// only hold valid MsgEthereumTx with a recoverable signature for this chain id
+// and a gas limit of at least the intrinsic gas, and the gas limit of the
+// Cosmos tx must match the sum of their gas limits, which is returned.
+func (h *ProposalHandler) checkEthTx(ctx sdk.Context, tx sdk.Tx) (uint64, error) {
+	chainID, err := etherminttypes.ParseChainID(ctx.ChainID())
+	if err != nil {
+		return 0, err
+	}
+	params := h.evmKeeper.GetParams(ctx)
+	ethCfg := params.GetChainConfig().EthereumConfig(chainID)
+	blockNum := big.NewInt(ctx.BlockHeight())
+	blockTime := uint64(ctx.BlockTime().Unix())
+	signer := ethtypes.MakeSigner(ethCfg, blockNum, blockTime)
+	rules := ethCfg.Rules(blockNum, true, blockTime)
+
+	var gas uint64
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return 0, fmt.Errorf("invalid message type %T in ethereum tx", msg)
+		}
+		if err := msgEthTx.ValidateBasic(); err != nil {
+			return 0, fmt.Errorf("invalid ethereum tx: %w", err)
+		}
+
+		ethTx := msgEthTx.AsTransaction()
+		if ethTx.Protected() && ethTx.ChainId().Cmp(chainID) != 0 {
+			return 0, fmt.Errorf("invalid ethereum tx chain id %s, expected %s", ethTx.ChainId(), chainID)
+		}
+		sender, err := signer.Sender(ethTx)
+		if err != nil {
+			return 0, fmt.Errorf("invalid ethereum tx signature: %w", err)
+		}
+		if err := checkUnprotectedTx(ctx, params, h.unprotectedTxs, ethTx, sender); err != nil {
+			return 0, fmt.Errorf("unprotected ethereum tx is not allowed: %w", err)
+		}
+
+		intrinsicGas, err := core.IntrinsicGas(ethTx.Data(), ethTx.AccessList(), ethTx.To() == nil, rules.IsHomestead, rules.IsIstanbul, rules.IsShanghai)
+		if err != nil {
+			return 0, fmt.Errorf("failed to compute intrinsic gas: %w", err)
+		}
+		if ethTx.Gas() < intrinsicGas {
+			return 0, fmt.Errorf("ethereum tx gas limit %d is below intrinsic gas %d", ethTx.Gas(), intrinsicGas)
+		}
+		gas += ethTx.Gas()
+	}
+
+	if gasTx, ok := tx.(baseapp.GasTx); !ok || gasTx.GetGas() != gas {
+		return 0, fmt.Errorf("tx gas limit does not match ethereum gas limit %d", gas)
+	}
+	return gas, nil
+}
+
+// voteExtensionsInjected reports whether the proposal of the given height
+// carries the extended commit of the previous height as its first transaction,
+// which is the case from the height after vote extensions are enabled.
+func voteExtensionsInjected(ctx sdk.Context, height int64) bool {
+	abciParams := ctx.ConsensusParams().Abci
+	return abciParams != nil && abciParams.VoteExtensionsEnableHeight > 0 && height > abciParams.VoteExtensionsEnableHeight
+}
+
+// rejectProposal logs why the proposal is rejected and returns the REJECT
+// response.
+func (h *ProposalHandler) rejectProposal(ctx sdk.Context, req *abci.RequestProcessProposal, txIndex int, reason error) (*abci.ResponseProcessProposal, error) {
+	ctx.Logger().Error(
+		"rejected proposal",
+		"height", req.Height,
+		"proposer", sdk.ConsAddress(req.ProposerAddress),
+		"tx_index", txIndex,
+		"reason", reason,
+	)
+	return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+}
+
+// exportLaneStats exports the block space used by every lane as telemetry
+// gauges and logs it.
+func (h *ProposalHandler) exportLaneStats(ctx sdk.Context, stats [numLanes]LaneStats) {
+	for i, s := range stats {
+		lane := Lane(i)
+		labels := []metrics.Label{telemetry.NewLabel("lane", lane.String())}
+		telemetry.SetGaugeWithLabels([]string{"tac", "proposal", "lane", "gas_limit"}, float32(s.Limit), labels)
+		telemetry.SetGaugeWithLabels([]string{"tac", "proposal", "lane", "gas_used"}, float32(s.GasUsed), labels)
+		telemetry.SetGaugeWithLabels([]string{"tac", "proposal", "lane", "txs"}, float32(s.NumTxs), labels)
+
+		ctx.Logger().Debug("prepared proposal lane", "lane", lane, "gas_limit", s.Limit, "gas_used", s.GasUsed, "txs", s.NumTxs)
+	}
+}
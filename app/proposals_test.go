@@ -0,0 +1,251 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"math/big"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	ibcchanneltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// testTxVerifier accepts every tx, standing in for BaseApp in proposal tests.
+type testTxVerifier struct {
+	txConfig client.TxConfig
+}
+
+func (v testTxVerifier) PrepareProposalVerifyTx(tx sdk.Tx) ([]byte, error) {
+	return v.TxEncode(tx)
+}
+
+func (v testTxVerifier) ProcessProposalVerifyTx(bz []byte) (sdk.Tx, error) {
+	return v.TxDecode(bz)
+}
+
+func (v testTxVerifier) TxDecode(bz []byte) (sdk.Tx, error) {
+	return v.txConfig.TxDecoder()(bz)
+}
+
+func (v testTxVerifier) TxEncode(tx sdk.Tx) ([]byte, error) {
+	return v.txConfig.TxEncoder()(tx)
+}
+
+// testEVMKeeper returns the default EVM params, standing in for the EVM keeper
+// in proposal tests.
+type testEVMKeeper struct{}
+
+func (testEVMKeeper) GetParams(sdk.Context) evmtypes.Params {
+	return evmtypes.DefaultParams()
+}
+
+// newTestTxConfig returns a tx config that decodes bank and EVM txs.
+func newTestTxConfig() client.TxConfig {
+	encodingConfig := MakeEncodingConfig()
+	banktypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	evmtypes.RegisterInterfaces(encodingConfig.InterfaceRegistry)
+	return encodingConfig.TxConfig
+}
+
+// newTestSignedEthTx returns the encoded Cosmos tx of a signed Ethereum transfer.
+func newTestSignedEthTx(t *testing.T, txConfig client.TxConfig, chainID *big.Int, gas uint64) []byte {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	to := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	ethTx, err := ethtypes.SignNewTx(key, ethtypes.LatestSignerForChainID(chainID), &ethtypes.LegacyTx{
+		To:       &to,
+		Gas:      gas,
+		GasPrice: big.NewInt(1),
+		Value:    big.NewInt(1),
+	})
+	require.NoError(t, err)
+
+	msg := &evmtypes.MsgEthereumTx{}
+	require.NoError(t, msg.FromEthereumTx(ethTx))
+
+	tx, err := msg.BuildTx(txConfig.NewTxBuilder(), BaseDenom)
+	require.NoError(t, err)
+	bz, err := txConfig.TxEncoder()(tx)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestTxLane(t *testing.T) {
+	priv := secp256k1.GenPrivKey()
+	addr := sdk.AccAddress(priv.PubKey().Address())
+
+	require.Equal(t, LaneEVM, TxLane(newTestEthTx(t, common.Address{}, 0)))
+	require.Equal(t, LaneCosmos, TxLane(newTestCosmosTx(t, priv, 0)))
+	require.Equal(t, LaneIBC, TxLane(newTestCosmosTxWithMsgs(t, priv, 0, 0, &ibcchanneltypes.MsgRecvPacket{}, &ibcchanneltypes.MsgAcknowledgement{})))
+	require.Equal(t, LaneCosmos, TxLane(newTestCosmosTxWithMsgs(t, priv, 0, 0, &ibcchanneltypes.MsgRecvPacket{}, banktypes.NewMsgSend(addr, addr, sdk.NewCoins()))))
+}
+
+func TestPrepareProposalLanes(t *testing.T) {
+	mp := NewTacMempool(DefaultTacConfig().Mempool, nil, MakeEncodingConfig().TxConfig.TxEncoder())
+	ctx := newTestMempoolContext(t).WithConsensusParams(cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxGas: 110_000},
+	})
+	verifier := testTxVerifier{txConfig: MakeEncodingConfig().TxConfig}
+
+	// a flood of well paying eth txs, 21000 gas each
+	var ethTxs []sdk.Tx
+	for i := int64(0); i < 5; i++ {
+		tx := newTestEthTx(t, common.BigToAddress(big.NewInt(i+1)), 0)
+		require.NoError(t, mp.Insert(ctx.WithPriority(100-i), tx))
+		ethTxs = append(ethTxs, tx)
+	}
+	cosmosTx := newTestCosmosTxWithMsgs(t, secp256k1.GenPrivKey(), 0, 20_000, &banktypes.MsgSend{})
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), cosmosTx))
+	ibcTx := newTestCosmosTxWithMsgs(t, secp256k1.GenPrivKey(), 0, 20_000, &ibcchanneltypes.MsgRecvPacket{})
+	require.NoError(t, mp.Insert(ctx.WithPriority(5), ibcTx))
+
+	handler := NewProposalHandler(mp, verifier, nil, nil, nil, LanesConfig{EVMShare: 50, CosmosShare: 20, IBCShare: 20})
+	res, err := handler.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{MaxTxBytes: 1 << 20})
+	require.NoError(t, err)
+
+	// the EVM lane gets 55000 gas in the first pass, the cosmos and IBC txs fit
+	// into their lanes and the remaining 28000 gas go to one more eth tx
+	var expected [][]byte
+	for _, tx := range []sdk.Tx{ethTxs[0], ethTxs[1], cosmosTx, ibcTx, ethTxs[2]} {
+		bz, err := verifier.TxEncode(tx)
+		require.NoError(t, err)
+		expected = append(expected, bz)
+	}
+	require.Equal(t, expected, res.Txs)
+}
+
+func TestPrepareProposalLanesKeepSequence(t *testing.T) {
+	mp := NewTacMempool(DefaultTacConfig().Mempool, nil, MakeEncodingConfig().TxConfig.TxEncoder())
+	ctx := newTestMempoolContext(t).WithConsensusParams(cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxGas: 100_000},
+	})
+	verifier := testTxVerifier{txConfig: MakeEncodingConfig().TxConfig}
+
+	// alice's IBC tx does not fit into the IBC lane and is held back, so her
+	// next tx must not be selected ahead of it
+	alice := secp256k1.GenPrivKey()
+	aliceTx0 := newTestCosmosTxWithMsgs(t, alice, 0, 30_000, &ibcchanneltypes.MsgRecvPacket{})
+	aliceTx1 := newTestCosmosTxWithMsgs(t, alice, 1, 10_000, &banktypes.MsgSend{})
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), aliceTx0))
+	require.NoError(t, mp.Insert(ctx.WithPriority(10), aliceTx1))
+
+	handler := NewProposalHandler(mp, verifier, nil, nil, nil, LanesConfig{EVMShare: 50, CosmosShare: 30, IBCShare: 20})
+	res, err := handler.PrepareProposalHandler()(ctx, &abci.RequestPrepareProposal{MaxTxBytes: 1 << 20})
+	require.NoError(t, err)
+
+	var expected [][]byte
+	for _, tx := range []sdk.Tx{aliceTx0, aliceTx1} {
+		bz, err := verifier.TxEncode(tx)
+		require.NoError(t, err)
+		expected = append(expected, bz)
+	}
+	require.Equal(t, expected, res.Txs)
+}
+
+func TestProcessProposal(t *testing.T) {
+	chainID := big.NewInt(2390)
+	ctx := newTestMempoolContext(t).WithChainID(DefaultChainID).WithConsensusParams(cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxGas: 100_000},
+	})
+	txConfig := newTestTxConfig()
+
+	// a nil chain id signs a pre-EIP-155 tx
+	allowlistedTx := newTestSignedEthTx(t, txConfig, nil, 21_000)
+	decoded, err := txConfig.TxDecoder()(allowlistedTx)
+	require.NoError(t, err)
+	unprotectedTxs := testUnprotectedTxKeeper{hashes: map[common.Hash]bool{
+		decoded.GetMsgs()[0].(*evmtypes.MsgEthereumTx).AsTransaction().Hash(): true,
+	}}
+	handler := NewProposalHandler(nil, testTxVerifier{txConfig: txConfig}, testEVMKeeper{}, unprotectedTxs, nil, DefaultTacConfig().Lanes)
+
+	cosmosTx, err := txConfig.TxEncoder()(newTestCosmosTxWithMsgs(t, secp256k1.GenPrivKey(), 0, 50_000, &banktypes.MsgSend{}))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		txs    [][]byte
+		accept bool
+	}{
+		{
+			name:   "valid txs",
+			txs:    [][]byte{newTestSignedEthTx(t, txConfig, chainID, 21_000), cosmosTx},
+			accept: true,
+		},
+		{
+			name: "undecodable tx",
+			txs:  [][]byte{newTestSignedEthTx(t, txConfig, chainID, 21_000), []byte("not a tx")},
+		},
+		{
+			name: "wrong chain id",
+			txs:  [][]byte{newTestSignedEthTx(t, txConfig, big.NewInt(1), 21_000)},
+		},
+		{
+			name:   "allowlisted unprotected tx",
+			txs:    [][]byte{allowlistedTx},
+			accept: true,
+		},
+		{
+			name: "unprotected tx",
+			txs:  [][]byte{newTestSignedEthTx(t, txConfig, nil, 21_000)},
+		},
+		{
+			name: "gas limit below intrinsic gas",
+			txs:  [][]byte{newTestSignedEthTx(t, txConfig, chainID, 20_999)},
+		},
+		{
+			name: "block gas over max_gas across both VMs",
+			txs:  [][]byte{newTestSignedEthTx(t, txConfig, chainID, 30_000), newTestSignedEthTx(t, txConfig, chainID, 30_000), cosmosTx},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			res, err := handler.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Txs: tc.txs, Height: 1})
+			require.NoError(t, err)
+			if tc.accept {
+				require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+			} else {
+				require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+			}
+		})
+	}
+}
+
+func TestProcessProposalRequiresExtendedCommit(t *testing.T) {
+	ctx := newTestMempoolContext(t).WithChainID(DefaultChainID).WithConsensusParams(cmtproto.ConsensusParams{
+		Block: &cmtproto.BlockParams{MaxGas: 100_000},
+		Abci:  &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 1},
+	})
+	txConfig := newTestTxConfig()
+	handler := NewProposalHandler(nil, testTxVerifier{txConfig: txConfig}, testEVMKeeper{}, nil, nil, DefaultTacConfig().Lanes)
+
+	// the first proposal with vote extensions must start with the extended commit
+	for _, txs := range [][][]byte{nil, {[]byte("not an extended commit")}} {
+		res, err := handler.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Txs: txs, Height: 2})
+		require.NoError(t, err)
+		require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status)
+	}
+
+	// no extended commit is injected at the enable height
+	res, err := handler.ProcessProposalHandler()(ctx, &abci.RequestProcessProposal{Height: 1})
+	require.NoError(t, err)
+	require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status)
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+)
+
+var _ sdk.AnteDecorator = RelayPriorityDecorator{}
+
+// RelayClientKeeper defines the IBC client keeper reads used to tell client
+// updates that advance a client from replayed ones.
+type RelayClientKeeper interface {
+	GetClientState(ctx sdk.Context, clientID string) (exported.ClientState, bool)
+}
+
+// RelayPriorityDecorator boosts the mempool priority of the txs of the IBC lane,
+// so that relayer txs are proposed ahead of, and evicted after, other txs paying
+// the same fee. It must run before ibcante.RedundantRelayDecorator, which
+// applies client updates to the CheckTx state.
+//
+// A tx only gets the boost if it relays a packet or advances a client. Packet
+// messages are verified against their proofs and rejected when redundant by the
+// RedundantRelayDecorator, so copies of the txs of an honest relayer cannot
+// take its place; client updates are not, so updates to heights the client has
+// already seen are not boosted.
+type RelayPriorityDecorator struct {
+	clientKeeper RelayClientKeeper
+	boost        uint64
+}
+
+// NewRelayPriorityDecorator creates a new RelayPriorityDecorator raising the
+// priority of relayer txs by boost percent.
+func NewRelayPriorityDecorator(clientKeeper RelayClientKeeper, boost uint64) RelayPriorityDecorator {
+	return RelayPriorityDecorator{
+		clientKeeper: clientKeeper,
+		boost:        boost,
+	}
+}
+
+// AnteHandle boosts the priority set by the rest of the ante handler in
+// CheckTx and ReCheckTx.
+func (rpd RelayPriorityDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !ctx.IsCheckTx() || simulate || rpd.boost == 0 || TxLane(tx) != LaneIBC || !rpd.relays(ctx, tx) {
+		return next(ctx, tx, simulate)
+	}
+
+	newCtx, err := next(ctx, tx, simulate)
+	if err != nil {
+		return newCtx, err
+	}
+	return newCtx.WithPriority(boostPriority(newCtx.Priority(), rpd.boost)), nil
+}
+
+// relays reports whether tx relays a packet or advances a client.
+func (rpd RelayPriorityDecorator) relays(ctx sdk.Context, tx sdk.Tx) bool {
+	for _, msg := range tx.GetMsgs() {
+		update, ok := msg.(*ibcclienttypes.MsgUpdateClient)
+		if !ok {
+			// every other message of the IBC lane is a packet message
+			return true
+		}
+
+		clientMsg, err := ibcclienttypes.UnpackClientMessage(update.ClientMessage)
+		if err != nil {
+			continue
+		}
+		header, ok := clientMsg.(interface{ GetHeight() exported.Height })
+		if !ok {
+			continue
+		}
+		clientState, found := rpd.clientKeeper.GetClientState(ctx, update.ClientId)
+		if found && header.GetHeight().GT(clientState.GetLatestHeight()) {
+			return true
+		}
+	}
+	return false
+}
+
+// boostPriority raises priority by boost percent, saturating at the maximum
+// priority.
+func boostPriority(priority int64, boost uint64) int64 {
+	if priority <= 0 {
+		return priority
+	}
+
+	if boost > math.MaxInt64-100 || priority > math.MaxInt64/int64(100+boost) {
+		return math.MaxInt64
+	}
+	return priority * int64(100+boost) / 100
+}
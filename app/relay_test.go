@@ -0,0 +1,105 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"math"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	ibcchanneltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/cosmos/ibc-go/v8/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
+)
+
+// testRelayClientKeeper holds a single tendermint client at revision 1.
+type testRelayClientKeeper struct {
+	latestHeight uint64
+}
+
+func (k testRelayClientKeeper) GetClientState(_ sdk.Context, clientID string) (exported.ClientState, bool) {
+	if clientID != "07-tendermint-0" {
+		return nil, false
+	}
+	return &ibctm.ClientState{LatestHeight: ibcclienttypes.NewHeight(1, k.latestHeight)}, true
+}
+
+func newTestMsgUpdateClient(t *testing.T, clientID string, height int64) *ibcclienttypes.MsgUpdateClient {
+	t.Helper()
+
+	header := &ibctm.Header{SignedHeader: &cmtproto.SignedHeader{
+		Header: &cmtproto.Header{ChainID: "counterparty-1", Height: height},
+	}}
+	msg, err := ibcclienttypes.NewMsgUpdateClient(clientID, header, "relayer")
+	require.NoError(t, err)
+	return msg
+}
+
+func TestRelayPriorityDecorator(t *testing.T) {
+	ctx := newTestMempoolContext(t).WithIsCheckTx(true)
+	priv := secp256k1.GenPrivKey()
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx.WithPriority(10), nil }
+	decorator := NewRelayPriorityDecorator(testRelayClientKeeper{latestHeight: 100}, 50)
+
+	testCases := []struct {
+		name     string
+		msgs     []sdk.Msg
+		priority int64
+	}{
+		{
+			name:     "packet relay",
+			msgs:     []sdk.Msg{newTestMsgUpdateClient(t, "07-tendermint-0", 100), &ibcchanneltypes.MsgRecvPacket{}},
+			priority: 15,
+		},
+		{
+			name:     "client update to a new height",
+			msgs:     []sdk.Msg{newTestMsgUpdateClient(t, "07-tendermint-0", 101)},
+			priority: 15,
+		},
+		{
+			name:     "replayed client update",
+			msgs:     []sdk.Msg{newTestMsgUpdateClient(t, "07-tendermint-0", 100)},
+			priority: 10,
+		},
+		{
+			name:     "unknown client",
+			msgs:     []sdk.Msg{newTestMsgUpdateClient(t, "07-tendermint-1", 101)},
+			priority: 10,
+		},
+		{
+			name:     "packet relay along with other messages",
+			msgs:     []sdk.Msg{&ibcchanneltypes.MsgRecvPacket{}, &banktypes.MsgSend{}},
+			priority: 10,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			newCtx, err := decorator.AnteHandle(ctx, newTestCosmosTxWithMsgs(t, priv, 0, 0, tc.msgs...), false, next)
+			require.NoError(t, err)
+			require.Equal(t, tc.priority, newCtx.Priority())
+		})
+	}
+
+	// the boost only affects the mempool
+	tx := newTestCosmosTxWithMsgs(t, priv, 0, 0, &ibcchanneltypes.MsgRecvPacket{})
+	newCtx, err := decorator.AnteHandle(ctx.WithIsCheckTx(false), tx, false, next)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), newCtx.Priority())
+}
+
+func TestBoostPriority(t *testing.T) {
+	require.Equal(t, int64(200), boostPriority(100, 100))
+	require.Equal(t, int64(0), boostPriority(0, 100))
+	require.Equal(t, int64(math.MaxInt64), boostPriority(math.MaxInt64/2, 100))
+	require.Equal(t, int64(math.MaxInt64), boostPriority(1, math.MaxUint64))
+}
@@ -0,0 +1,107 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	evmcalltypes "github.com/Asphere-xyz/tacchain/x/evmcall/types"
+)
+
+var _ sdk.PostDecorator = RevenueDecorator{}
+
+// RevenueKeeper pays a share of the fees of the txs to the registered
+// contracts they call.
+type RevenueKeeper interface {
+	DistributeRevenue(ctx sdk.Context, contracts []sdk.AccAddress, fees sdk.Coins) (sdk.Coins, error)
+}
+
+// revenuePaidKey is the context key of the fees paid to the registered
+// contracts, left out of the fees split by the FeeBurnDecorator.
+type revenuePaidKey struct{}
+
+// RevenueDecorator has the revenue module pay the developer share of the fees
+// of a tx, base fee included, to the registered contracts its messages call. It
+// runs in block execution only, before the FeeBurnDecorator burns and splits
+// the rest of the fees.
+//
+// Only the contracts called directly by a message earn revenue: the recipient
+// of an Ethereum tx and the contract of a MsgCall or a MsgExecuteContract. The
+// contracts they call in turn, by nested EVM calls or wasm sub-messages, and
+// the messages wrapped in other messages, earn nothing, and each message of the
+// tx gets an equal part of the share.
+type RevenueDecorator struct {
+	txFees
+	revenueKeeper RevenueKeeper
+}
+
+// NewRevenueDecorator creates a new RevenueDecorator.
+func NewRevenueDecorator(rk RevenueKeeper, ek FeeBurnEVMKeeper, fak FeeAbsKeeper) RevenueDecorator {
+	return RevenueDecorator{
+		txFees:        txFees{evmKeeper: ek, feeAbsKeeper: fak},
+		revenueKeeper: rk,
+	}
+}
+
+// PostHandle pays the revenue of the contracts called by the tx. The work isn't
+// charged to the tx, so that the gas used of Ethereum txs matches their
+// receipts.
+func (rd RevenueDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	if simulate || !success || ctx.IsCheckTx() {
+		return next(ctx, tx, simulate, success)
+	}
+
+	revenueCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	contracts, err := calledContracts(tx)
+	if err != nil {
+		return ctx, err
+	}
+	baseFee, tips, err := rd.split(revenueCtx, tx)
+	if err != nil {
+		return ctx, err
+	}
+
+	paid, err := rd.revenueKeeper.DistributeRevenue(revenueCtx, contracts, baseFee.Add(tips...))
+	if err != nil {
+		return ctx, errorsmod.Wrap(err, "failed to distribute revenue")
+	}
+	if !paid.IsZero() {
+		ctx = ctx.WithValue(revenuePaidKey{}, paid)
+	}
+	return next(ctx, tx, simulate, success)
+}
+
+// calledContracts returns the contract called by each message of a tx, or nil
+// for the messages calling none.
+func calledContracts(tx sdk.Tx) ([]sdk.AccAddress, error) {
+	msgs := tx.GetMsgs()
+	contracts := make([]sdk.AccAddress, len(msgs))
+	for i, msg := range msgs {
+		switch msg := msg.(type) {
+		case *evmtypes.MsgEthereumTx:
+			txData, err := evmtypes.UnpackTxData(msg.Data)
+			if err != nil {
+				return nil, err
+			}
+			if to := txData.GetTo(); to != nil {
+				contracts[i] = to.Bytes()
+			}
+		case *evmcalltypes.MsgCall:
+			if contract, err := evmcalltypes.ParseContract(msg.Contract); err == nil {
+				contracts[i] = contract.Bytes()
+			}
+		case *wasmtypes.MsgExecuteContract:
+			// the contract address was validated with the message
+			contracts[i], _ = sdk.AccAddressFromBech32(msg.Contract)
+		}
+	}
+	return contracts, nil
+}
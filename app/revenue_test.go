@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	evmcalltypes "github.com/Asphere-xyz/tacchain/x/evmcall/types"
+)
+
+// testRevenueKeeper records the called contracts, and pays half of the fees.
+type testRevenueKeeper struct {
+	contracts []sdk.AccAddress
+	fees      sdk.Coins
+}
+
+func (k *testRevenueKeeper) DistributeRevenue(_ sdk.Context, contracts []sdk.AccAddress, fees sdk.Coins) (sdk.Coins, error) {
+	k.contracts = contracts
+	k.fees = fees
+	paid := sdk.NewCoins()
+	for _, coin := range fees {
+		paid = paid.Add(sdk.NewCoin(coin.Denom, coin.Amount.QuoRaw(2)))
+	}
+	return paid, nil
+}
+
+func TestRevenueDecorator(t *testing.T) {
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin(BaseDenom, amount)) }
+	contract := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	wasmContract := sdk.AccAddress(make([]byte, 32))
+	newEthTx := func(to *common.Address, gasPrice int64) sdk.Tx {
+		msg := evmtypes.NewTx(big.NewInt(2390), 0, to, big.NewInt(0), 100_000, big.NewInt(gasPrice), nil, nil, nil, nil)
+		tx, err := msg.BuildTx(MakeEncodingConfig().TxConfig.NewTxBuilder(), BaseDenom)
+		require.NoError(t, err)
+		return tx
+	}
+	newCosmosTx := func(msgs ...sdk.Msg) sdk.Tx {
+		builder := MakeEncodingConfig().TxConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...))
+		builder.SetFeeAmount(coins(1_000_000))
+		builder.SetGasLimit(100_000)
+		return builder.GetTx()
+	}
+	sender := sdk.AccAddress(testSender.Bytes())
+
+	testCases := []struct {
+		name      string
+		tx        sdk.Tx
+		contracts []sdk.AccAddress
+		baseFee   sdk.Coins
+		tips      sdk.Coins
+	}{
+		{
+			name:      "ethereum call",
+			tx:        newEthTx(&contract, 10),
+			contracts: []sdk.AccAddress{contract.Bytes()},
+			baseFee:   coins(21_000 * 7),
+			tips:      coins(21_000 * 3),
+		},
+		{
+			name:      "ethereum call at the base fee",
+			tx:        newEthTx(&contract, 7),
+			contracts: []sdk.AccAddress{contract.Bytes()},
+			baseFee:   coins(21_000 * 7),
+			tips:      coins(0),
+		},
+		{
+			name:      "ethereum contract creation",
+			tx:        newEthTx(nil, 10),
+			contracts: []sdk.AccAddress{nil},
+			baseFee:   coins(21_000 * 7),
+			tips:      coins(21_000 * 3),
+		},
+		{
+			name: "cosmos tx",
+			tx: newCosmosTx(
+				&wasmtypes.MsgExecuteContract{Sender: sender.String(), Contract: wasmContract.String(), Msg: []byte("{}")},
+				banktypes.NewMsgSend(sender, testGranter, coins(1)),
+				evmcalltypes.NewMsgCall(sender, contract, nil, sdkmath.ZeroInt(), 50_000),
+			),
+			contracts: []sdk.AccAddress{wasmContract, nil, contract.Bytes()},
+			baseFee:   coins(700_000),
+			tips:      coins(300_000),
+		},
+	}
+
+	evmKeeper := testFeeBurnEVMKeeper{baseFee: big.NewInt(7), gasUsed: 21_000}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := newTestMempoolContext(t)
+			revenueKeeper := &testRevenueKeeper{}
+			feeBurnKeeper := &testFeeBurnKeeper{}
+			postHandler := sdk.ChainPostDecorators(
+				NewRevenueDecorator(revenueKeeper, evmKeeper, nil),
+				NewFeeBurnDecorator(feeBurnKeeper, evmKeeper, nil),
+			)
+
+			_, err := postHandler(ctx, tc.tx, false, true)
+			require.NoError(t, err)
+			require.Equal(t, tc.contracts, revenueKeeper.contracts)
+			// the revenue is shared from the whole fees, base fee included
+			require.Equal(t, tc.baseFee.Add(tc.tips...), revenueKeeper.fees)
+
+			// the revenue paid, half of the fees, is deducted from the base fee
+			// and the tips before they are burned and split
+			require.True(t, tc.baseFee.QuoInt(sdkmath.NewInt(2)).Equal(feeBurnKeeper.baseFee), feeBurnKeeper.baseFee)
+			require.True(t, tc.tips.QuoInt(sdkmath.NewInt(2)).Equal(feeBurnKeeper.tips), feeBurnKeeper.tips)
+
+			// the revenue is only paid in block execution
+			revenueKeeper.contracts = nil
+			_, err = postHandler(ctx.WithIsCheckTx(true), tc.tx, false, true)
+			require.NoError(t, err)
+			_, err = postHandler(ctx, tc.tx, false, false)
+			require.NoError(t, err)
+			require.Nil(t, revenueKeeper.contracts)
+		})
+	}
+}
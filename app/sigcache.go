@@ -0,0 +1,200 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	metrics "github.com/hashicorp/go-metrics"
+	lru "github.com/hashicorp/golang-lru/v2"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ethermintante "github.com/evmos/ethermint/app/ante"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+var _ sdk.AnteDecorator = EthSigVerificationDecorator{}
+
+// SigCache is a bounded LRU cache of the senders recovered from the signatures
+// of Ethereum txs during CheckTx or by the Prefetcher, keyed by tx hash, so that
+// FinalizeBlock does not recover them again. It is safe for concurrent use.
+type SigCache struct {
+	senders *lru.Cache[common.Hash, cachedSender]
+}
+
+// cachedSender is a recovered sender along with the signer it was recovered
+// with, since a tx is only valid for the signers of its chain id and forks.
+type cachedSender struct {
+	sender common.Address
+	signer ethtypes.Signer
+}
+
+// NewSigCache creates a SigCache holding up to size senders. It returns nil,
+// which disables caching, if size is zero.
+func NewSigCache(size int) *SigCache {
+	if size <= 0 {
+		return nil
+	}
+
+	senders, err := lru.New[common.Hash, cachedSender](size)
+	if err != nil {
+		panic(err)
+	}
+	return &SigCache{senders: senders}
+}
+
+// Len returns the number of cached senders.
+func (c *SigCache) Len() int {
+	if c == nil {
+		return 0
+	}
+	return c.senders.Len()
+}
+
+// sender returns the sender of ethTx, from the cache if it was recovered with
+// an equal signer before. The ante handler only adds senders to the cache in
+// CheckTx and ReCheckTx, the senders of the txs of a block are added ahead of
+// its execution by the prefetcher, see recoverSender.
+func (c *SigCache) sender(ctx sdk.Context, signer ethtypes.Signer, ethTx *ethtypes.Transaction, simulate bool) (common.Address, error) {
+	if c == nil || simulate {
+		return signer.Sender(ethTx)
+	}
+
+	if cached, ok := c.senders.Get(ethTx.Hash()); ok && cached.signer.Equal(signer) {
+		sigCacheMetric(ctx, "hit")
+		return cached.sender, nil
+	}
+	sigCacheMetric(ctx, "miss")
+
+	sender, err := signer.Sender(ethTx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	if ctx.IsCheckTx() {
+		c.senders.Add(ethTx.Hash(), cachedSender{sender: sender, signer: signer})
+	}
+	return sender, nil
+}
+
+// recoverSender returns the sender of ethTx, from the cache if it was recovered
+// with an equal signer before, and adds it to the cache otherwise. It's used to
+// recover the senders of the txs of a proposal ahead of block execution.
+func (c *SigCache) recoverSender(signer ethtypes.Signer, ethTx *ethtypes.Transaction) (common.Address, error) {
+	if c == nil {
+		return signer.Sender(ethTx)
+	}
+
+	if cached, ok := c.senders.Get(ethTx.Hash()); ok && cached.signer.Equal(signer) {
+		return cached.sender, nil
+	}
+
+	sender, err := signer.Sender(ethTx)
+	if err != nil {
+		return common.Address{}, err
+	}
+	c.senders.Add(ethTx.Hash(), cachedSender{sender: sender, signer: signer})
+	return sender, nil
+}
+
+// invalidate removes the senders of the Ethereum txs of tx from the cache.
+func (c *SigCache) invalidate(tx sdk.Tx) {
+	if c == nil {
+		return
+	}
+
+	for _, msg := range tx.GetMsgs() {
+		if msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx); ok {
+			c.senders.Remove(msgEthTx.AsTransaction().Hash())
+		}
+	}
+}
+
+// sigCacheMetric counts a cache hit or miss, labelled by execution mode.
+func sigCacheMetric(ctx sdk.Context, result string) {
+	mode := "deliver"
+	switch {
+	case ctx.IsReCheckTx():
+		mode = "recheck"
+	case ctx.IsCheckTx():
+		mode = "check"
+	}
+	telemetry.IncrCounterWithLabels(
+		[]string{"tac", "ante", "sig_cache", result},
+		1,
+		[]metrics.Label{telemetry.NewLabel("mode", mode)},
+	)
+}
+
+// EthSigVerificationDecorator replaces ethermint's EthSigVerificationDecorator.
+// It runs the same checks, but takes the senders recovered in CheckTx from the
+// SigCache. A tx that fails the rest of the ante handler in CheckTx or
+// ReCheckTx is removed from the cache, so that only the senders of txs that may
+// still be included in a block are kept.
+type EthSigVerificationDecorator struct {
+	evmKeeper      ethermintante.EVMKeeper
+	unprotectedTxs UnprotectedTxKeeper
+	sigCache       *SigCache
+}
+
+// NewEthSigVerificationDecorator creates a new EthSigVerificationDecorator. A
+// nil cache recovers every sender.
+func NewEthSigVerificationDecorator(ek ethermintante.EVMKeeper, uk UnprotectedTxKeeper, sigCache *SigCache) EthSigVerificationDecorator {
+	return EthSigVerificationDecorator{
+		evmKeeper:      ek,
+		unprotectedTxs: uk,
+		sigCache:       sigCache,
+	}
+}
+
+// AnteHandle sets the sender of the Ethereum txs from the signature, and checks
+// that they are protected unless allowed by the EVM params or allowlisted. It's
+// not skipped for ReCheckTx, because the sender is required by the other
+// decorators.
+func (esvd EthSigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	chainID := esvd.evmKeeper.ChainID()
+	evmParams := esvd.evmKeeper.GetParams(ctx)
+	ethCfg := evmParams.GetChainConfig().EthereumConfig(chainID)
+	blockNum := big.NewInt(ctx.BlockHeight())
+	signer := ethtypes.MakeSigner(ethCfg, blockNum, uint64(ctx.BlockTime().Unix()))
+
+	for _, msg := range tx.GetMsgs() {
+		msgEthTx, ok := msg.(*evmtypes.MsgEthereumTx)
+		if !ok {
+			return ctx, errorsmod.Wrapf(errortypes.ErrUnknownRequest, "invalid message type %T, expected %T", msg, (*evmtypes.MsgEthereumTx)(nil))
+		}
+
+		ethTx := msgEthTx.AsTransaction()
+		sender, err := esvd.sigCache.sender(ctx, signer, ethTx, simulate)
+		if err != nil {
+			return ctx, errorsmod.Wrapf(
+				errortypes.ErrorInvalidSigner,
+				"couldn't retrieve sender address from the ethereum transaction: %s",
+				err.Error(),
+			)
+		}
+		// the sender is recovered first, since unprotected txs may be allowlisted
+		// by sender
+		if err := checkUnprotectedTx(ctx, evmParams, esvd.unprotectedTxs, ethTx, sender); err != nil {
+			esvd.sigCache.invalidate(tx)
+			return ctx, err
+		}
+
+		// set up the sender to the transaction field if not already
+		msgEthTx.From = sender.Hex()
+	}
+
+	newCtx, err := next(ctx, tx, simulate)
+	if err != nil && ctx.IsCheckTx() && !simulate {
+		esvd.sigCache.invalidate(tx)
+	}
+	return newCtx, err
+}
@@ -0,0 +1,129 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+
+	ethermintante "github.com/evmos/ethermint/app/ante"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// testAnteEVMKeeper implements the EVM keeper methods used by the signature
+// verification.
+type testAnteEVMKeeper struct {
+	ethermintante.EVMKeeper
+	testEVMKeeper
+}
+
+func (testAnteEVMKeeper) ChainID() *big.Int { return big.NewInt(2390) }
+
+func (k testAnteEVMKeeper) GetParams(ctx sdk.Context) evmtypes.Params {
+	return k.testEVMKeeper.GetParams(ctx)
+}
+
+func TestEthSigVerificationDecoratorCache(t *testing.T) {
+	txConfig := newTestTxConfig()
+	bz := newTestSignedEthTx(t, txConfig, big.NewInt(2390), 21_000)
+	decodeTx := func() (sdk.Tx, *evmtypes.MsgEthereumTx) {
+		tx, err := txConfig.TxDecoder()(bz)
+		require.NoError(t, err)
+		return tx, tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx)
+	}
+
+	cache := NewSigCache(10)
+	decorator := NewEthSigVerificationDecorator(testAnteEVMKeeper{}, nil, cache)
+	ctx := newTestMempoolContext(t).WithBlockHeight(1)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	// the sender is recovered in CheckTx and cached
+	tx, msg := decodeTx()
+	_, err := decorator.AnteHandle(ctx.WithIsCheckTx(true), tx, false, next)
+	require.NoError(t, err)
+	require.Equal(t, 1, cache.Len())
+	sender := msg.From
+	require.NotEmpty(t, sender)
+
+	// block execution takes the sender from the cache
+	cached, ok := cache.senders.Get(msg.AsTransaction().Hash())
+	require.True(t, ok)
+	fake := common.HexToAddress("0x0000000000000000000000000000000000000bad")
+	cache.senders.Add(msg.AsTransaction().Hash(), cachedSender{sender: fake, signer: cached.signer})
+
+	tx, msg = decodeTx()
+	_, err = decorator.AnteHandle(ctx, tx, false, next)
+	require.NoError(t, err)
+	require.Equal(t, fake.Hex(), msg.From)
+
+	// a tx failing ReCheckTx is removed from the cache
+	failing := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, errors.New("nonce too low") }
+	tx, _ = decodeTx()
+	_, err = decorator.AnteHandle(ctx.WithIsCheckTx(true).WithIsReCheckTx(true), tx, false, failing)
+	require.Error(t, err)
+	require.Equal(t, 0, cache.Len())
+
+	// block execution recovers senders that are not cached, without caching them
+	tx, msg = decodeTx()
+	_, err = decorator.AnteHandle(ctx, tx, false, next)
+	require.NoError(t, err)
+	require.Equal(t, sender, msg.From)
+	require.Equal(t, 0, cache.Len())
+}
+
+func TestEthSigVerificationDecoratorUnprotected(t *testing.T) {
+	txConfig := newTestTxConfig()
+	ctx := newTestMempoolContext(t).WithBlockHeight(1).WithIsCheckTx(true)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	decodeTx := func(bz []byte) (sdk.Tx, *ethtypes.Transaction) {
+		tx, err := txConfig.TxDecoder()(bz)
+		require.NoError(t, err)
+		return tx, tx.GetMsgs()[0].(*evmtypes.MsgEthereumTx).AsTransaction()
+	}
+
+	// a nil chain id signs a pre-EIP-155 tx
+	tx, ethTx := decodeTx(newTestSignedEthTx(t, txConfig, nil, 21_000))
+	require.False(t, ethTx.Protected())
+	sender, err := ethtypes.HomesteadSigner{}.Sender(ethTx)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name    string
+		keeper  UnprotectedTxKeeper
+		allowed bool
+	}{
+		{"no allowlist", nil, false},
+		{"not allowlisted", testUnprotectedTxKeeper{}, false},
+		{"allowlisted hash", testUnprotectedTxKeeper{hashes: map[common.Hash]bool{ethTx.Hash(): true}}, true},
+		{"allowlisted sender", testUnprotectedTxKeeper{senders: map[common.Address]bool{sender: true}}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cache := NewSigCache(10)
+			_, err := NewEthSigVerificationDecorator(testAnteEVMKeeper{}, tc.keeper, cache).AnteHandle(ctx, tx, false, next)
+			if tc.allowed {
+				require.NoError(t, err)
+				require.Equal(t, 1, cache.Len())
+			} else {
+				require.ErrorIs(t, err, errortypes.ErrNotSupported)
+				require.Equal(t, 0, cache.Len())
+			}
+		})
+	}
+
+	// protected txs don't need to be allowlisted
+	tx, _ = decodeTx(newTestSignedEthTx(t, txConfig, big.NewInt(2390), 21_000))
+	_, err = NewEthSigVerificationDecorator(testAnteEVMKeeper{}, testUnprotectedTxKeeper{}, nil).AnteHandle(ctx, tx, false, next)
+	require.NoError(t, err)
+}
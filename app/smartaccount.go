@@ -0,0 +1,188 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"context"
+
+	signingv1beta1 "cosmossdk.io/api/cosmos/tx/signing/v1beta1"
+	errorsmod "cosmossdk.io/errors"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	smartaccounttypes "github.com/Asphere-xyz/tacchain/x/smartaccount/types"
+)
+
+var _ sdk.AnteDecorator = SmartAccountDecorator{}
+
+// SmartAccountKeeper validates the txs of the smart accounts with the hooks of
+// their contracts.
+type SmartAccountKeeper interface {
+	IsSmartAccount(ctx context.Context, addr sdk.AccAddress) (bool, error)
+	IsContract(ctx sdk.Context, addr sdk.AccAddress) bool
+	MaxValidationGas(ctx context.Context) (uint64, error)
+	ValidateTransaction(ctx sdk.Context, account sdk.AccAddress, signBytes, signature []byte) error
+}
+
+// SmartAccountDecorator verifies the txs signed by smart accounts, which are
+// contract accounts without keys. Their signatures are validated by calling the
+// hook of their contract on the SIGN_MODE_DIRECT sign bytes of the tx, within
+// the gas budget set by governance, in place of the signature verification
+// decorators, which run for the other txs.
+//
+// A tx is signed by a smart account if its single signer is a smart account,
+// or if its messages only register its single signer, a contract, as a smart
+// account: the first tx of a smart account is validated by its hook like the
+// others, so that the contracts consent to become smart accounts.
+type SmartAccountDecorator struct {
+	accountKeeper      authante.AccountKeeper
+	smartAccountKeeper SmartAccountKeeper
+	signModeHandler    *txsigning.HandlerMap
+	txEncoder          sdk.TxEncoder
+	sigVerification    sdk.AnteHandler
+}
+
+// NewSmartAccountDecorator creates a new SmartAccountDecorator, running the
+// sigDecorators to verify the signatures of the txs not signed by smart
+// accounts.
+func NewSmartAccountDecorator(ak authante.AccountKeeper, sak SmartAccountKeeper, signModeHandler *txsigning.HandlerMap, txEncoder sdk.TxEncoder, sigDecorators ...sdk.AnteDecorator) SmartAccountDecorator {
+	return SmartAccountDecorator{
+		accountKeeper:      ak,
+		smartAccountKeeper: sak,
+		signModeHandler:    signModeHandler,
+		txEncoder:          txEncoder,
+		sigVerification:    sdk.ChainAnteDecorators(sigDecorators...),
+	}
+}
+
+// AnteHandle validates the txs signed by smart accounts with their hooks, and
+// verifies the signatures of the other txs with the signature verification
+// decorators.
+func (sad SmartAccountDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sigTx, ok := tx.(authsigning.Tx)
+	if !ok {
+		return ctx, errorsmod.Wrap(errortypes.ErrTxDecode, "invalid transaction type")
+	}
+	account, ok, err := sad.smartAccountSigner(ctx, sigTx)
+	if err != nil {
+		return ctx, err
+	}
+	if !ok {
+		newCtx, err := sad.sigVerification(ctx, tx, simulate)
+		if err != nil {
+			return newCtx, err
+		}
+		return next(newCtx, tx, simulate)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+	if len(sigs) != 1 {
+		return ctx, errorsmod.Wrapf(errortypes.ErrUnauthorized, "smart account txs must have a single signature, got %d", len(sigs))
+	}
+	acc, err := authante.GetSignerAcc(ctx, sad.accountKeeper, account)
+	if err != nil {
+		return ctx, err
+	}
+	if sigs[0].Sequence != acc.GetSequence() {
+		return ctx, errorsmod.Wrapf(errortypes.ErrWrongSequence, "account sequence mismatch, expected %d, got %d", acc.GetSequence(), sigs[0].Sequence)
+	}
+
+	// charge the whole gas budget of the hook when simulating, as the tx isn't
+	// signed yet
+	if simulate {
+		gas, err := sad.smartAccountKeeper.MaxValidationGas(ctx)
+		if err != nil {
+			return ctx, err
+		}
+		ctx.GasMeter().ConsumeGas(gas, "smart account validation")
+		return next(ctx, tx, simulate)
+	}
+	// no need to validate the txs on recheck tx
+	if ctx.IsReCheckTx() || !ctx.IsSigverifyTx() {
+		return next(ctx, tx, simulate)
+	}
+
+	sig, ok := sigs[0].Data.(*signingtypes.SingleSignatureData)
+	if !ok || sig.SignMode != signingtypes.SignMode_SIGN_MODE_DIRECT {
+		return ctx, errorsmod.Wrap(errortypes.ErrNotSupported, "smart account txs must be signed with SIGN_MODE_DIRECT")
+	}
+	var accNum uint64
+	if ctx.BlockHeight() > 0 {
+		accNum = acc.GetAccountNumber()
+	}
+	signerData := txsigning.SignerData{
+		Address:       acc.GetAddress().String(),
+		ChainID:       ctx.ChainID(),
+		AccountNumber: accNum,
+		Sequence:      acc.GetSequence(),
+	}
+	txData, err := sad.directSigningTxData(tx)
+	if err != nil {
+		return ctx, err
+	}
+	signBytes, err := sad.signModeHandler.GetSignBytes(ctx, signingv1beta1.SignMode_SIGN_MODE_DIRECT, signerData, txData)
+	if err != nil {
+		return ctx, errorsmod.Wrap(errortypes.ErrUnauthorized, err.Error())
+	}
+	if err := sad.smartAccountKeeper.ValidateTransaction(ctx, account, signBytes, sig.Signature); err != nil {
+		return ctx, errorsmod.Wrapf(errortypes.ErrUnauthorized, "smart account %s rejected the tx: %s", account, err)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// smartAccountSigner returns the single signer of the tx if it's a smart
+// account, or a contract registering as a smart account.
+func (sad SmartAccountDecorator) smartAccountSigner(ctx sdk.Context, tx authsigning.Tx) (sdk.AccAddress, bool, error) {
+	signers, err := tx.GetSigners()
+	if err != nil {
+		return nil, false, err
+	}
+	if len(signers) != 1 {
+		return nil, false, nil
+	}
+	account := sdk.AccAddress(signers[0])
+
+	registered, err := sad.smartAccountKeeper.IsSmartAccount(ctx, account)
+	if err != nil || registered {
+		return account, registered, err
+	}
+
+	msgs := tx.GetMsgs()
+	for _, msg := range msgs {
+		register, ok := msg.(*smartaccounttypes.MsgRegister)
+		if !ok || register.Account != account.String() {
+			return nil, false, nil
+		}
+	}
+	return account, len(msgs) > 0 && sad.smartAccountKeeper.IsContract(ctx, account), nil
+}
+
+// directSigningTxData returns the tx data signed in SIGN_MODE_DIRECT, i.e. the
+// body and the auth info bytes of the tx as broadcast, which the tx encoder
+// keeps for the decoded txs. The bytes must not be re-encoded from the decoded
+// tx, as the hook would then validate other bytes than the signed ones. The
+// GetSigningTxData of the SDK txs cannot be used, as it doesn't support the
+// signer infos without public keys of the smart accounts.
+func (sad SmartAccountDecorator) directSigningTxData(tx sdk.Tx) (txsigning.TxData, error) {
+	bz, err := sad.txEncoder(tx)
+	if err != nil {
+		return txsigning.TxData{}, errorsmod.Wrap(errortypes.ErrTxDecode, err.Error())
+	}
+	var raw txtypes.TxRaw
+	if err := raw.Unmarshal(bz); err != nil {
+		return txsigning.TxData{}, errorsmod.Wrap(errortypes.ErrTxDecode, err.Error())
+	}
+	return txsigning.TxData{BodyBytes: raw.BodyBytes, AuthInfoBytes: raw.AuthInfoBytes}, nil
+}
@@ -0,0 +1,273 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"context"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	smartaccountkeeper "github.com/Asphere-xyz/tacchain/x/smartaccount/keeper"
+	smartaccounttypes "github.com/Asphere-xyz/tacchain/x/smartaccount/types"
+)
+
+// testWalletCode is the code of an EVM wallet whose validateTransaction hook
+// approves the txs whose signature starts with 0x01, and reverts otherwise.
+func testWalletCode() []byte {
+	code := []byte{
+		0x60, 0x64, // PUSH1 0x64, the offset of the signature in the calldata
+		0x35,       // CALLDATALOAD
+		0x60, 0xf8, // PUSH1 0xf8
+		0x1c,       // SHR
+		0x60, 0x01, // PUSH1 0x01
+		0x14,       // EQ
+		0x60, 0x10, // PUSH1 0x10
+		0x57,             // JUMPI
+		0x60, 0x00, 0x80, // PUSH1 0x00 DUP1
+		0xfd, // REVERT
+		0x5b, // JUMPDEST
+		0x63, // PUSH4 selector
+	}
+	code = append(code, smartaccounttypes.ValidateTransactionSelector...)
+	return append(code,
+		0x60, 0xe0, // PUSH1 0xe0
+		0x1b,       // SHL
+		0x60, 0x00, // PUSH1 0x00
+		0x52,       // MSTORE
+		0x60, 0x20, // PUSH1 0x20
+		0x60, 0x00, // PUSH1 0x00
+		0xf3, // RETURN
+	)
+}
+
+func TestSmartAccountDecorator(t *testing.T) {
+	app := newTestEIP712App(t)
+	ctx := app.NewContext(false).WithChainID(testEIP712ChainID).WithBlockHeight(1)
+	// the EVM pays the block proposer
+	validators, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+	ctx = ctx.WithProposer(consAddr)
+	txConfig := app.TxConfig()
+	decorator := NewSmartAccountDecorator(app.AccountKeeper, app.SmartAccountKeeper, txConfig.SignModeHandler(), txConfig.TxEncoder(),
+		NewSigVerificationDecorator(app.AccountKeeper, txConfig.SignModeHandler()))
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	// deploy the wallet
+	wallet := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	code := testWalletCode()
+	app.EvmKeeper.SetCode(ctx, crypto.Keccak256(code), code)
+	require.NoError(t, app.EvmKeeper.SetAccount(ctx, wallet, statedb.Account{Balance: new(uint256.Int), CodeHash: crypto.Keccak256(code)}))
+	account := sdk.AccAddress(wallet.Bytes())
+
+	newTx := func(sig byte, sequence uint64, msgs ...sdk.Msg) sdk.Tx {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(msgs...))
+		builder.SetGasLimit(200_000)
+		require.NoError(t, builder.SetSignatures(signingtypes.SignatureV2{
+			Data:     &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT, Signature: []byte{sig, 0xaa}},
+			Sequence: sequence,
+		}))
+		return decodeTestTx(t, txConfig, builder)
+	}
+	send := banktypes.NewMsgSend(account, sdk.AccAddress("recipient___________"), sdk.NewCoins(sdk.NewInt64Coin(BaseDenom, 1)))
+	register := smartaccounttypes.NewMsgRegister(account)
+
+	// the contracts without keys cannot sign the txs before registering
+	_, err = decorator.AnteHandle(ctx, newTx(0x01, 0, send), false, next)
+	require.ErrorIs(t, err, errortypes.ErrInvalidPubKey)
+
+	// the registration is validated by the hook
+	_, err = decorator.AnteHandle(ctx, newTx(0x02, 0, register), false, next)
+	require.ErrorIs(t, err, errortypes.ErrUnauthorized)
+	_, err = decorator.AnteHandle(ctx, newTx(0x01, 0, register), false, next)
+	require.NoError(t, err)
+	_, err = decorator.AnteHandle(ctx, newTx(0x01, 0, register, send), false, next)
+	require.ErrorIs(t, err, errortypes.ErrInvalidPubKey)
+
+	_, err = smartaccountkeeper.NewMsgServerImpl(app.SmartAccountKeeper).Register(ctx, register)
+	require.NoError(t, err)
+
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(1_000_000))
+	_, err = decorator.AnteHandle(ctx, newTx(0x01, 0, send), false, next)
+	require.NoError(t, err)
+	require.NotZero(t, ctx.GasMeter().GasConsumed())
+
+	_, err = decorator.AnteHandle(ctx, newTx(0x02, 0, send), false, next)
+	require.ErrorIs(t, err, errortypes.ErrUnauthorized)
+	_, err = decorator.AnteHandle(ctx, newTx(0x01, 1, send), false, next)
+	require.ErrorIs(t, err, errortypes.ErrWrongSequence)
+
+	// the whole gas budget is charged when simulating
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	_, err = decorator.AnteHandle(ctx, newTx(0x00, 0, send), true, next)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), smartaccounttypes.DefaultMaxValidationGas)
+
+	// the smart accounts sign in SIGN_MODE_DIRECT only
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(send))
+	require.NoError(t, builder.SetSignatures(signingtypes.SignatureV2{
+		Data: &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, Signature: []byte{0x01}},
+	}))
+	_, err = decorator.AnteHandle(ctx, decodeTestTx(t, txConfig, builder), false, next)
+	require.ErrorIs(t, err, errortypes.ErrNotSupported)
+}
+
+// recordingSmartAccountKeeper records the sign bytes of the validated txs.
+type recordingSmartAccountKeeper struct {
+	SmartAccountKeeper
+	signBytes []byte
+}
+
+func (k *recordingSmartAccountKeeper) ValidateTransaction(ctx sdk.Context, account sdk.AccAddress, signBytes, signature []byte) error {
+	k.signBytes = signBytes
+	return k.SmartAccountKeeper.ValidateTransaction(ctx, account, signBytes, signature)
+}
+
+func TestSmartAccountDecoratorSignBytes(t *testing.T) {
+	app := newTestEIP712App(t)
+	ctx := app.NewContext(false).WithChainID(testEIP712ChainID).WithBlockHeight(1)
+	validators, err := app.StakingKeeper.GetAllValidators(ctx)
+	require.NoError(t, err)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+	ctx = ctx.WithProposer(consAddr)
+	txConfig := app.TxConfig()
+	keeper := &recordingSmartAccountKeeper{SmartAccountKeeper: app.SmartAccountKeeper}
+	decorator := NewSmartAccountDecorator(app.AccountKeeper, keeper, txConfig.SignModeHandler(), txConfig.TxEncoder(),
+		NewSigVerificationDecorator(app.AccountKeeper, txConfig.SignModeHandler()))
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	wallet := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	code := testWalletCode()
+	app.EvmKeeper.SetCode(ctx, crypto.Keccak256(code), code)
+	require.NoError(t, app.EvmKeeper.SetAccount(ctx, wallet, statedb.Account{Balance: new(uint256.Int), CodeHash: crypto.Keccak256(code)}))
+	account := sdk.AccAddress(wallet.Bytes())
+	_, err = smartaccountkeeper.NewMsgServerImpl(app.SmartAccountKeeper).Register(ctx, smartaccounttypes.NewMsgRegister(account))
+	require.NoError(t, err)
+	acc := app.AccountKeeper.GetAccount(ctx, account)
+
+	builder := txConfig.NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(account, sdk.AccAddress("recipient___________"), sdk.NewCoins(sdk.NewInt64Coin(BaseDenom, 1)))))
+	builder.SetMemo("memo")
+	builder.SetGasLimit(200_000)
+	require.NoError(t, builder.SetSignatures(signingtypes.SignatureV2{
+		Data: &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT, Signature: []byte{0x01}},
+	}))
+	bz, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+
+	// encode the body with the memo before the messages, which decodes to the
+	// same tx
+	var raw txtypes.TxRaw
+	require.NoError(t, raw.Unmarshal(bz))
+	var body txtypes.TxBody
+	require.NoError(t, body.Unmarshal(raw.BodyBytes))
+	memo, err := (&txtypes.TxBody{Memo: body.Memo}).Marshal()
+	require.NoError(t, err)
+	messages, err := (&txtypes.TxBody{Messages: body.Messages}).Marshal()
+	require.NoError(t, err)
+	raw.BodyBytes = append(memo, messages...)
+	bz, err = raw.Marshal()
+	require.NoError(t, err)
+	tx, err := txConfig.TxDecoder()(bz)
+	require.NoError(t, err)
+
+	// the hook validates the bytes as broadcast
+	_, err = decorator.AnteHandle(ctx, tx, false, next)
+	require.NoError(t, err)
+	signDoc, err := (&txtypes.SignDoc{BodyBytes: raw.BodyBytes, AuthInfoBytes: raw.AuthInfoBytes, ChainId: testEIP712ChainID, AccountNumber: acc.GetAccountNumber()}).Marshal()
+	require.NoError(t, err)
+	require.Equal(t, signDoc, keeper.signBytes)
+}
+
+func TestSmartAccountCheckTx(t *testing.T) {
+	app := newTestEIP712App(t)
+	// finalize the genesis block so that the check state is set, the EVM pays
+	// its proposer
+	validators, err := app.StakingKeeper.GetAllValidators(app.NewContext(false))
+	require.NoError(t, err)
+	consAddr, err := validators[0].GetConsAddr()
+	require.NoError(t, err)
+	_, err = app.FinalizeBlock(&abci.RequestFinalizeBlock{Height: 1, ProposerAddress: consAddr})
+	require.NoError(t, err)
+	_, err = app.Commit()
+	require.NoError(t, err)
+	ctx := app.NewContext(true)
+	txConfig := app.TxConfig()
+
+	// deploy, register and fund the wallet in the check state
+	wallet := common.HexToAddress("0x1000000000000000000000000000000000000001")
+	code := testWalletCode()
+	app.EvmKeeper.SetCode(ctx, crypto.Keccak256(code), code)
+	require.NoError(t, app.EvmKeeper.SetAccount(ctx, wallet, statedb.Account{Balance: new(uint256.Int), CodeHash: crypto.Keccak256(code)}))
+	account := sdk.AccAddress(wallet.Bytes())
+	_, err = smartaccountkeeper.NewMsgServerImpl(app.SmartAccountKeeper).Register(ctx, smartaccounttypes.NewMsgRegister(account))
+	require.NoError(t, err)
+	coins := sdk.NewCoins(sdk.NewInt64Coin(BaseDenom, 1_000_000))
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+	require.NoError(t, app.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, account, coins))
+
+	checkTx := func(sig byte) *abci.ResponseCheckTx {
+		builder := txConfig.NewTxBuilder()
+		require.NoError(t, builder.SetMsgs(banktypes.NewMsgSend(account, sdk.AccAddress("recipient___________"), sdk.NewCoins(sdk.NewInt64Coin(BaseDenom, 1)))))
+		builder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(BaseDenom, 1_000)))
+		builder.SetGasLimit(200_000)
+		require.NoError(t, builder.SetSignatures(signingtypes.SignatureV2{
+			Data:     &signingtypes.SingleSignatureData{SignMode: signingtypes.SignMode_SIGN_MODE_DIRECT, Signature: []byte{sig, 0xaa}},
+			Sequence: 0,
+		}))
+		bz, err := txConfig.TxEncoder()(builder.GetTx())
+		require.NoError(t, err)
+		res, err := app.CheckTx(&abci.RequestCheckTx{Tx: bz, Type: abci.CheckTxType_New})
+		require.NoError(t, err)
+		return res
+	}
+
+	// the txs rejected by the hook don't enter the mempool
+	res := checkTx(0x02)
+	require.Equal(t, errortypes.ErrUnauthorized.ABCICode(), res.Code, res.Log)
+	require.Zero(t, app.mempool.CountTx())
+
+	// the txs without public keys are inserted with the smart account as sender
+	res = checkTx(0x01)
+	require.Zero(t, res.Code, res.Log)
+	require.Equal(t, 1, app.mempool.CountTx())
+	signers, err := app.mempool.signerExtractor.GetSigners(selectAll(context.Background(), app.mempool)[0])
+	require.NoError(t, err)
+	require.Len(t, signers, 1)
+	require.Equal(t, account, signers[0].Signer)
+	require.Zero(t, signers[0].Sequence)
+}
+
+// decodeTestTx encodes and decodes the tx of builder, like the nodes.
+func decodeTestTx(t *testing.T, txConfig client.TxConfig, builder client.TxBuilder) sdk.Tx {
+	t.Helper()
+
+	bz, err := txConfig.TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	tx, err := txConfig.TxDecoder()(bz)
+	require.NoError(t, err)
+	return tx
+}
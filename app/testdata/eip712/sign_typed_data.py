@@ -0,0 +1,123 @@
+# Signs the EIP-712 typed data of the JSON files with the test key of
+# TestEIP712Vectors, like eth_signTypedData_v4, and prints their digest and
+# wallet signature (v = 27 or 28):
+#
+#   python3 sign_typed_data.py 4c0883a69102937d6231471b5dbb6204fe5129617082792ae468d01a3f362318 delegate.json vote.json transfer.json
+#
+# It implements Keccak-256, the EIP-712 encoding and RFC 6979 secp256k1
+# signatures from their specs, without sharing code with go-ethereum, so that
+# the vectors don't depend on the implementation under test.
+import json, hmac, hashlib, sys
+
+# --- keccak256 ---
+RC = [0x0000000000000001,0x0000000000008082,0x800000000000808A,0x8000000080008000,
+0x000000000000808B,0x0000000080000001,0x8000000080008081,0x8000000000008009,
+0x000000000000008A,0x0000000000000088,0x0000000080008009,0x000000008000000A,
+0x000000008000808B,0x800000000000008B,0x8000000000008089,0x8000000000008003,
+0x8000000000008002,0x8000000000000080,0x000000000000800A,0x800000008000000A,
+0x8000000080008081,0x8000000000008080,0x0000000080000001,0x8000000080008008]
+ROT = [[0,36,3,41,18],[1,44,10,45,2],[62,6,43,15,61],[28,55,25,21,56],[27,20,39,8,14]]
+M = (1<<64)-1
+def rol(x,n): return ((x<<n)|(x>>(64-n)))&M if n else x
+def f(A):
+    for rc in RC:
+        C=[A[x][0]^A[x][1]^A[x][2]^A[x][3]^A[x][4] for x in range(5)]
+        D=[C[(x-1)%5]^rol(C[(x+1)%5],1) for x in range(5)]
+        A=[[A[x][y]^D[x] for y in range(5)] for x in range(5)]
+        B=[[0]*5 for _ in range(5)]
+        for x in range(5):
+            for y in range(5):
+                B[y][(2*x+3*y)%5]=rol(A[x][y],ROT[x][y])
+        A=[[B[x][y]^((~B[(x+1)%5][y])&B[(x+2)%5][y]) for y in range(5)] for x in range(5)]
+        A[0][0]^=rc
+    return A
+def keccak256(data):
+    rate=136
+    p=bytearray(data)+b'\x01'
+    while len(p)%rate: p+=b'\x00'
+    p[-1]|=0x80
+    A=[[0]*5 for _ in range(5)]
+    for i in range(0,len(p),rate):
+        blk=p[i:i+rate]
+        for j in range(rate//8):
+            x,y=j%5,j//5
+            A[x][y]^=int.from_bytes(blk[8*j:8*j+8],'little')
+        A=f(A)
+    out=b''
+    for j in range(4):
+        out+=A[j%5][j//5].to_bytes(8,'little')
+    return out
+assert keccak256(b'').hex()=='c5d2460186f7233c927e7db2dcc703c0e500b653ca82273b7bfad8045d85a470'
+
+# --- EIP-712 ---
+def deps(types, t, found):
+    t=t.split('[')[0]
+    if t in found or t not in types: return found
+    found.append(t)
+    for fld in types[t]: deps(types, fld['type'], found)
+    return found
+def encode_type(types, t):
+    d=deps(types,t,[])
+    d=[t]+sorted(x for x in d if x!=t)
+    return ''.join(x+'('+','.join(fl['type']+' '+fl['name'] for fl in types[x])+')' for x in d)
+def type_hash(types,t): return keccak256(encode_type(types,t).encode())
+def to_int(v):
+    if isinstance(v,str): return int(v,16) if v.startswith('0x') else int(v)
+    return int(v)
+def encode_value(types, t, v):
+    if t in types:
+        return keccak256(encode_data(types,t,v))
+    if t.endswith(']'):
+        base=t[:t.rindex('[')]
+        return keccak256(b''.join(encode_value(types,base,x) for x in v))
+    if t=='string': return keccak256(v.encode())
+    if t=='bytes': return keccak256(bytes.fromhex(v[2:]))
+    if t=='bool': return (1 if v else 0).to_bytes(32,'big')
+    if t=='address': return to_int(v).to_bytes(32,'big')
+    if t.startswith('uint'): return to_int(v).to_bytes(32,'big')
+    if t.startswith('int'): return (to_int(v)%(1<<256)).to_bytes(32,'big')
+    raise ValueError(t)
+def encode_data(types,t,v):
+    return type_hash(types,t)+b''.join(encode_value(types,fl['type'],v[fl['name']]) for fl in types[t])
+def digest(td):
+    types=td['types']
+    return keccak256(b'\x19\x01'+keccak256(encode_data(types,'EIP712Domain',td['domain']))+keccak256(encode_data(types,td['primaryType'],td['message'])))
+
+# --- secp256k1 ---
+P=2**256-2**32-977; N=0xFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFFEBAAEDCE6AF48A03BBFD25E8CD0364141
+G=(0x79BE667EF9DCBBAC55A06295CE870B07029BFCDB2DCE28D959F2815B16F81798,0x483ADA7726A3C4655DA4FBFC0E1108A8FD17B448A68554199C47D08FFB10D4B8)
+def add(a,b):
+    if a is None: return b
+    if b is None: return a
+    if a[0]==b[0] and (a[1]+b[1])%P==0: return None
+    l=((3*a[0]*a[0])*pow(2*a[1],-1,P) if a==b else (b[1]-a[1])*pow(b[0]-a[0],-1,P))%P
+    x=(l*l-a[0]-b[0])%P
+    return (x,(l*(a[0]-x)-a[1])%P)
+def mul(k,pt):
+    r=None
+    while k:
+        if k&1: r=add(r,pt)
+        pt=add(pt,pt); k>>=1
+    return r
+def rfc6979(d,h):
+    x=d.to_bytes(32,'big'); V=b'\x01'*32; K=b'\x00'*32
+    hm=(int.from_bytes(h,'big')%N).to_bytes(32,'big')
+    K=hmac.new(K,V+b'\x00'+x+hm,hashlib.sha256).digest(); V=hmac.new(K,V,hashlib.sha256).digest()
+    K=hmac.new(K,V+b'\x01'+x+hm,hashlib.sha256).digest(); V=hmac.new(K,V,hashlib.sha256).digest()
+    while True:
+        V=hmac.new(K,V,hashlib.sha256).digest()
+        k=int.from_bytes(V,'big')
+        if 1<=k<N: return k
+        K=hmac.new(K,V+b'\x00',hashlib.sha256).digest(); V=hmac.new(K,V,hashlib.sha256).digest()
+def sign(d,h):
+    k=rfc6979(d,h); R=mul(k,G); r=R[0]%N
+    s=pow(k,-1,N)*(int.from_bytes(h,'big')+r*d)%N
+    v=R[1]&1
+    if s>N//2: s=N-s; v^=1
+    return r.to_bytes(32,'big')+s.to_bytes(32,'big')+bytes([27+v])
+
+d=int(sys.argv[1],16)
+for path in sys.argv[2:]:
+    td=json.load(open(path))
+    h=digest(td)
+    print(path, h.hex(), sign(d,h).hex())
@@ -11,24 +11,38 @@ import (
 
 	"github.com/Asphere-xyz/tacchain/app/upgrades"
 	ethermintgethv11315 "github.com/Asphere-xyz/tacchain/app/upgrades/ethermint-geth-v1.13.15"
+	fixvalidatorsstate "github.com/Asphere-xyz/tacchain/app/upgrades/fix-validators-state"
+	v007 "github.com/Asphere-xyz/tacchain/app/upgrades/v0.0.7"
 )
 
 // Upgrades list of chain upgrades
 var Upgrades = []upgrades.Upgrade{
 	ethermintgethv11315.Upgrade,
+	v007.Upgrade,
 }
 
-// RegisterUpgradeHandlers registers the chain upgrade handlers
-func (app *TacChainApp) RegisterUpgradeHandlers() {
-	keepers := upgrades.AppKeepers{
+// Forks list of in-state fixes applied without a governance upgrade
+var Forks = []upgrades.Fork{
+	fixvalidatorsstate.Fork,
+}
+
+// appKeepers returns the keepers available to upgrade handlers and forks
+func (app *TacChainApp) appKeepers() *upgrades.AppKeepers {
+	return &upgrades.AppKeepers{
 		AccountKeeper:         &app.AccountKeeper,
 		ParamsKeeper:          &app.ParamsKeeper,
 		ConsensusParamsKeeper: &app.ConsensusParamsKeeper,
 		CapabilityKeeper:      app.CapabilityKeeper,
 		IBCKeeper:             app.IBCKeeper,
+		StakingKeeper:         app.StakingKeeper,
 		Codec:                 app.appCodec,
 		GetStoreKey:           app.GetKey,
 	}
+}
+
+// RegisterUpgradeHandlers registers the chain upgrade handlers
+func (app *TacChainApp) RegisterUpgradeHandlers() {
+	keepers := app.appKeepers()
 	app.GetStoreKeys()
 	// register all upgrade handlers
 	for _, upgrade := range Upgrades {
@@ -37,7 +51,7 @@ func (app *TacChainApp) RegisterUpgradeHandlers() {
 			upgrade.CreateUpgradeHandler(
 				app.ModuleManager,
 				app.configurator,
-				&keepers,
+				keepers,
 			),
 		)
 	}
@@ -59,3 +73,13 @@ func (app *TacChainApp) RegisterUpgradeHandlers() {
 		}
 	}
 }
+
+// RegisterForks checks the forks and registers their query service. The forks
+// are applied in the BeginBlocker.
+func (app *TacChainApp) RegisterForks() {
+	if err := upgrades.ValidateForks(Forks); err != nil {
+		panic(fmt.Sprintf("invalid forks: %s", err))
+	}
+
+	upgrades.RegisterQueryServer(app.GRPCQueryRouter(), upgrades.NewQueryServer(Forks))
+}
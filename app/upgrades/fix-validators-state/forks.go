@@ -0,0 +1,74 @@
+package fixvalidatorsstate
+
+import (
+	"bytes"
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/Asphere-xyz/tacchain/app/upgrades"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+// ForkName defines the fork name
+const ForkName = "fix-validators-state"
+
+// Fork fixes the tacchain_2390-1 chain halt at height 3192450
+var Fork = upgrades.Fork{
+	Name:           ForkName,
+	ChainID:        "tacchain_2390-1",
+	Height:         3192450,
+	BeginForkLogic: BeginForkLogic,
+}
+
+// BeginForkLogic removes the duplicate entries of the validators from the
+// staking power index and rebuilds it.
+func BeginForkLogic(ctx sdk.Context, ak *upgrades.AppKeepers) error {
+	validators, err := ak.StakingKeeper.GetAllValidators(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get all validators: %s", err)
+	}
+
+	for _, validator := range validators {
+		store := ctx.KVStore(ak.GetStoreKey(stakingtypes.StoreKey))
+
+		deleted := false
+
+		iterator := storetypes.KVStorePrefixIterator(store, stakingtypes.ValidatorsByPowerIndexKey)
+		defer iterator.Close()
+
+		for ; iterator.Valid(); iterator.Next() {
+			valAddr := stakingtypes.ParseValidatorPowerRankKey(iterator.Key())
+			val := sdk.ValAddress(valAddr).String()
+
+			// get operator addr
+			var operator sdk.ValAddress = nil
+			if validator.OperatorAddress != "" {
+				addr, err := sdk.ValAddressFromBech32(validator.OperatorAddress)
+				if err != nil {
+					return fmt.Errorf("failed to parse operator address: %s", err)
+				}
+				operator = addr
+			}
+
+			if bytes.Equal(valAddr, operator) {
+				if deleted {
+					ctx.Logger().Info("removing duplicate validator from power index", "validator", val)
+				} else {
+					deleted = true
+				}
+				store.Delete(iterator.Key())
+			}
+		}
+
+		if err := ak.StakingKeeper.SetValidatorByPowerIndex(ctx, validator); err != nil {
+			return fmt.Errorf("failed to set validator by power index: %s", err)
+		}
+		if _, err := ak.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx); err != nil {
+			return fmt.Errorf("failed to apply and return validator set updates: %s", err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,123 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package upgrades
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Fork defines an in-state fix that is applied at the beginning of a block of
+// a given chain, without a governance upgrade. A fork must implement this
+// struct, and then add it to the forks of the app.go, which runs it
+// automatically at its height.
+type Fork struct {
+	// Name identifies the fix in logs and queries, e.g. `fix-validators-state`
+	Name string
+	// ChainID is the chain the fix applies to
+	ChainID string
+	// Height is the height of the block at the beginning of which the fix runs
+	Height int64
+
+	// BeginForkLogic defines the function that applies the fix
+	BeginForkLogic func(sdk.Context, *AppKeepers) error
+}
+
+// ValidateForks checks that every fork is complete and that no two forks share
+// a name, or a chain id and height, as their order would be undefined.
+func ValidateForks(forks []Fork) error {
+	names := make(map[string]struct{}, len(forks))
+	heights := make(map[string]string, len(forks))
+	for _, fork := range forks {
+		switch {
+		case fork.Name == "":
+			return errors.New("fork name must not be empty")
+		case fork.ChainID == "":
+			return fmt.Errorf("fork %s: chain id must not be empty", fork.Name)
+		case fork.Height <= 0:
+			return fmt.Errorf("fork %s: height must be positive, got %d", fork.Name, fork.Height)
+		case fork.BeginForkLogic == nil:
+			return fmt.Errorf("fork %s: begin fork logic must not be nil", fork.Name)
+		}
+
+		if _, ok := names[fork.Name]; ok {
+			return fmt.Errorf("duplicate fork %s", fork.Name)
+		}
+		names[fork.Name] = struct{}{}
+
+		key := fmt.Sprintf("%s/%d", fork.ChainID, fork.Height)
+		if other, ok := heights[key]; ok {
+			return fmt.Errorf("forks %s and %s are both scheduled at height %d of chain %s", other, fork.Name, fork.Height, fork.ChainID)
+		}
+		heights[key] = fork.Name
+	}
+	return nil
+}
+
+// BeginForks applies the forks scheduled at the height of the block of ctx.
+// The forks must have been validated with ValidateForks.
+func BeginForks(ctx sdk.Context, forks []Fork, keepers *AppKeepers) error {
+	for _, fork := range forks {
+		if fork.ChainID != ctx.ChainID() || fork.Height != ctx.BlockHeight() {
+			continue
+		}
+
+		ctx.Logger().Info("applying fork", "name", fork.Name, "height", fork.Height)
+		if err := fork.BeginForkLogic(ctx, keepers); err != nil {
+			return fmt.Errorf("failed to apply fork %s: %w", fork.Name, err)
+		}
+		ctx.Logger().Info("applied fork", "name", fork.Name, "height", fork.Height)
+	}
+	return nil
+}
+
+type queryServer struct {
+	forks []Fork
+}
+
+var _ QueryServer = queryServer{}
+
+// NewQueryServer returns an implementation of the QueryServer interface
+// reporting the given forks.
+func NewQueryServer(forks []Fork) QueryServer {
+	return queryServer{forks: forks}
+}
+
+// Forks implements the Query/Forks gRPC method.
+func (q queryServer) Forks(ctx context.Context, _ *QueryForksRequest) (*QueryForksResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	res := &QueryForksResponse{}
+	for _, fork := range q.forks {
+		if fork.ChainID != sdkCtx.ChainID() {
+			continue
+		}
+		res.Forks = append(res.Forks, ForkInfo{
+			Name:    fork.Name,
+			ChainId: fork.ChainID,
+			Height:  fork.Height,
+			Applied: sdkCtx.BlockHeight() >= fork.Height,
+		})
+	}
+	sort.Slice(res.Forks, func(i, j int) bool {
+		return res.Forks[i].Height < res.Forks[j].Height
+	})
+	return res, nil
+}
+
+// RegisterGRPCGatewayRoutes mounts the upgrades query service on the gRPC
+// Gateway mux.
+func RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
+	if err := RegisterQueryHandlerClient(context.Background(), mux, NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,79 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package upgrades
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+func noopForkLogic(sdk.Context, *AppKeepers) error { return nil }
+
+func TestValidateForks(t *testing.T) {
+	fork := Fork{Name: "fix", ChainID: "tacchain_2390-1", Height: 10, BeginForkLogic: noopForkLogic}
+
+	testCases := []struct {
+		name  string
+		forks []Fork
+		valid bool
+	}{
+		{"no forks", nil, true},
+		{"same height on other chains", []Fork{fork, {Name: "other", ChainID: "tacchain_2391-1", Height: 10, BeginForkLogic: noopForkLogic}}, true},
+		{"empty name", []Fork{{ChainID: "tacchain_2390-1", Height: 10, BeginForkLogic: noopForkLogic}}, false},
+		{"empty chain id", []Fork{{Name: "fix", Height: 10, BeginForkLogic: noopForkLogic}}, false},
+		{"zero height", []Fork{{Name: "fix", ChainID: "tacchain_2390-1", BeginForkLogic: noopForkLogic}}, false},
+		{"missing logic", []Fork{{Name: "fix", ChainID: "tacchain_2390-1", Height: 10}}, false},
+		{"duplicate name", []Fork{fork, {Name: "fix", ChainID: "tacchain_2390-1", Height: 11, BeginForkLogic: noopForkLogic}}, false},
+		{"same chain and height", []Fork{fork, {Name: "other", ChainID: "tacchain_2390-1", Height: 10, BeginForkLogic: noopForkLogic}}, false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateForks(tc.forks)
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestBeginForks(t *testing.T) {
+	key := storetypes.NewKVStoreKey("test")
+	ctx := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient_test")).WithLogger(log.NewNopLogger())
+
+	var applied []string
+	newFork := func(name, chainID string, height int64) Fork {
+		return Fork{Name: name, ChainID: chainID, Height: height, BeginForkLogic: func(sdk.Context, *AppKeepers) error {
+			applied = append(applied, name)
+			return nil
+		}}
+	}
+	forks := []Fork{
+		newFork("later", "tacchain_2390-1", 20),
+		newFork("fix", "tacchain_2390-1", 10),
+		newFork("other chain", "tacchain_2391-1", 10),
+	}
+
+	for height := int64(1); height <= 30; height++ {
+		require.NoError(t, BeginForks(ctx.WithChainID("tacchain_2390-1").WithBlockHeight(height), forks, nil))
+	}
+	require.Equal(t, []string{"fix", "later"}, applied)
+
+	res, err := NewQueryServer(forks).Forks(ctx.WithChainID("tacchain_2390-1").WithBlockHeight(15), &QueryForksRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []ForkInfo{
+		{Name: "fix", ChainId: "tacchain_2390-1", Height: 10, Applied: true},
+		{Name: "later", ChainId: "tacchain_2390-1", Height: 20, Applied: false},
+	}, res.Forks)
+}
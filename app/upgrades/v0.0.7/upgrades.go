@@ -0,0 +1,149 @@
+package v007
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/Asphere-xyz/tacchain/app/upgrades"
+	blocklisttypes "github.com/Asphere-xyz/tacchain/x/blocklist/types"
+	deployertypes "github.com/Asphere-xyz/tacchain/x/deployer/types"
+	feeabstypes "github.com/Asphere-xyz/tacchain/x/feeabs/types"
+	feeburntypes "github.com/Asphere-xyz/tacchain/x/feeburn/types"
+	msgfiltertypes "github.com/Asphere-xyz/tacchain/x/msgfilter/types"
+	oracletypes "github.com/Asphere-xyz/tacchain/x/oracle/types"
+	revenuetypes "github.com/Asphere-xyz/tacchain/x/revenue/types"
+	smartaccounttypes "github.com/Asphere-xyz/tacchain/x/smartaccount/types"
+	sponsortypes "github.com/Asphere-xyz/tacchain/x/sponsor/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+)
+
+// UpgradeName defines the on-chain upgrade name
+const UpgradeName = "v0.0.7"
+
+// PlanInfo is the JSON object of the info of the upgrade plan read by the
+// upgrade handler. Other fields, such as the binaries of cosmovisor, are
+// ignored.
+type PlanInfo struct {
+	// MinCommissionRate is the minimum commission rate of the validators agreed
+	// by governance, e.g. "0.05". The rate is left unchanged if it's empty.
+	MinCommissionRate string `json:"min_commission_rate"`
+}
+
+// ParseMinCommissionRate returns the minimum commission rate of the plan info,
+// and false if it has none. An info that is not a JSON object, such as the URL
+// of the binaries, has no rate.
+func ParseMinCommissionRate(info string) (sdkmath.LegacyDec, bool, error) {
+	var planInfo PlanInfo
+	if !strings.HasPrefix(strings.TrimSpace(info), "{") {
+		return sdkmath.LegacyDec{}, false, nil
+	}
+	if err := json.Unmarshal([]byte(info), &planInfo); err != nil {
+		return sdkmath.LegacyDec{}, false, fmt.Errorf("invalid upgrade plan info: %w", err)
+	}
+	if planInfo.MinCommissionRate == "" {
+		return sdkmath.LegacyDec{}, false, nil
+	}
+
+	rate, err := sdkmath.LegacyNewDecFromStr(planInfo.MinCommissionRate)
+	if err != nil {
+		return sdkmath.LegacyDec{}, false, fmt.Errorf("invalid min commission rate: %w", err)
+	}
+	if rate.IsNegative() || rate.GT(sdkmath.LegacyOneDec()) {
+		return sdkmath.LegacyDec{}, false, fmt.Errorf("min commission rate must be between 0 and 1, got %s", rate)
+	}
+	return rate, true, nil
+}
+
+var Upgrade = upgrades.Upgrade{
+	UpgradeName:          UpgradeName,
+	CreateUpgradeHandler: CreateUpgradeHandler,
+	StoreUpgrades: storetypes.StoreUpgrades{
+		Added: []string{
+			oracletypes.StoreKey,
+			msgfiltertypes.StoreKey,
+			deployertypes.StoreKey,
+			sponsortypes.StoreKey,
+			feeabstypes.StoreKey,
+			blocklisttypes.StoreKey,
+			feeburntypes.StoreKey,
+			revenuetypes.StoreKey,
+			smartaccounttypes.StoreKey,
+		},
+		Deleted: []string{},
+	},
+}
+
+// CreateUpgradeHandler runs the module migrations, which initializes the
+// modules added by the release with their default genesis state and creates
+// their module accounts. It then raises the minimum commission rate of
+// x/staking to the one of the plan info, and the commission rate of the
+// validators below it.
+func CreateUpgradeHandler(
+	mm upgrades.ModuleManager,
+	configurator module.Configurator,
+	ak *upgrades.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+		minRate, hasMinRate, err := ParseMinCommissionRate(plan.Info)
+		if err != nil {
+			return nil, err
+		}
+
+		vm, err := mm.RunMigrations(ctx, configurator, fromVM)
+		if err != nil {
+			return nil, err
+		}
+
+		params, err := ak.StakingKeeper.GetParams(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if hasMinRate && params.MinCommissionRate.LT(minRate) {
+			params.MinCommissionRate = minRate
+			if err := ak.StakingKeeper.SetParams(ctx, params); err != nil {
+				return nil, err
+			}
+		}
+
+		if err := RaiseCommissions(sdk.UnwrapSDKContext(ctx), ak, params.MinCommissionRate); err != nil {
+			return nil, err
+		}
+		return vm, nil
+	}
+}
+
+// RaiseCommissions raises the commission rate of the validators below minRate
+// to minRate, along with their max rate if it's below too.
+func RaiseCommissions(ctx sdk.Context, ak *upgrades.AppKeepers, minRate sdkmath.LegacyDec) error {
+	validators, err := ak.StakingKeeper.GetAllValidators(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get all validators: %w", err)
+	}
+
+	for _, validator := range validators {
+		commission := validator.Commission
+		if commission.Rate.GTE(minRate) {
+			continue
+		}
+
+		ctx.Logger().Info("raising validator commission rate", "validator", validator.OperatorAddress, "from", commission.Rate, "to", minRate)
+		commission.Rate = minRate
+		if commission.MaxRate.LT(minRate) {
+			commission.MaxRate = minRate
+		}
+		commission.UpdateTime = ctx.BlockTime()
+		validator.Commission = commission
+
+		if err := ak.StakingKeeper.SetValidator(ctx, validator); err != nil {
+			return fmt.Errorf("failed to set validator %s: %w", validator.OperatorAddress, err)
+		}
+	}
+	return nil
+}
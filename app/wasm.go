@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package app
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	oracletypes "github.com/Asphere-xyz/tacchain/x/oracle/types"
+)
+
+// wasmAcceptedQueries lists the gRPC queries contracts may run through the
+// Stargate and gRPC query plugins, mapped to their response types.
+func wasmAcceptedQueries() wasmkeeper.AcceptedQueries {
+	return wasmkeeper.AcceptedQueries{
+		"/tacchain.oracle.v1.Query/Params": &oracletypes.QueryParamsResponse{},
+		"/tacchain.oracle.v1.Query/Price":  &oracletypes.QueryPriceResponse{},
+		"/tacchain.oracle.v1.Query/Prices": &oracletypes.QueryPricesResponse{},
+	}
+}
+
+// oracleWasmQueryOption lets contracts read the oracle prices through the
+// Stargate and gRPC query plugins.
+func oracleWasmQueryOption(queryRouter *baseapp.GRPCQueryRouter, cdc codec.Codec) wasmkeeper.Option {
+	acceptList := wasmAcceptedQueries()
+	return wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+		Stargate: wasmkeeper.AcceptListStargateQuerier(acceptList, queryRouter, cdc),
+		Grpc:     wasmkeeper.AcceptListGrpcQuerier(acceptList, queryRouter, cdc),
+	})
+}
@@ -79,6 +79,9 @@ func initAppConfig() (string, interface{}) {
 		EVM     ethermintserverconfig.EVMConfig     `mapstructure:"evm"`
 		JSONRPC ethermintserverconfig.JSONRPCConfig `mapstructure:"json-rpc"`
 		TLS     ethermintserverconfig.TLSConfig     `mapstructure:"tls"`
+
+		// TacChain config
+		Tac app.TacConfig `mapstructure:"tac"`
 	}
 
 	// Optionally allow the chain developer to overwrite the SDK's default
@@ -106,11 +109,13 @@ func initAppConfig() (string, interface{}) {
 		EVM:     *ethermintserverconfig.DefaultEVMConfig(),
 		JSONRPC: *ethermintserverconfig.DefaultJSONRPCConfig(),
 		TLS:     *ethermintserverconfig.DefaultTLSConfig(),
+		Tac:     app.DefaultTacConfig(),
 	}
 
 	customAppTemplate := serverconfig.DefaultConfigTemplate +
 		wasmtypes.DefaultConfigTemplate() +
-		ethermintserverconfig.DefaultConfigTemplate
+		ethermintserverconfig.DefaultConfigTemplate +
+		app.TacConfigTemplate
 
 	return customAppTemplate, customAppConfig
 }
@@ -223,6 +228,7 @@ func txCommand() *cobra.Command {
 		authcmd.GetEncodeCommand(),
 		authcmd.GetDecodeCommand(),
 		authcmd.GetSimulateCmd(),
+		EIP712TypedDataCmd(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
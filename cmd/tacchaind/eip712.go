@@ -0,0 +1,95 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authclient "github.com/cosmos/cosmos-sdk/x/auth/client"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/Asphere-xyz/tacchain/app"
+)
+
+// EIP712TypedDataCmd returns the command printing the EIP-712 typed data of an
+// unsigned tx, which wallets sign with eth_signTypedData_v4 for SignModeEIP712.
+func EIP712TypedDataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "typed-data [file]",
+		Short: "Print the EIP-712 typed data of an unsigned transaction",
+		Long: `Print the EIP-712 typed data of an unsigned transaction generated with
+--generate-only, as signed by Ethereum wallets with eth_signTypedData_v4. The
+account number and sequence of the signer are queried unless --offline is set.
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.ChainID == "" {
+				return errors.New("the chain ID is required")
+			}
+
+			tx, err := authclient.ReadTxFromFile(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			sigTx, ok := tx.(interface {
+				authsigning.Tx
+				authsigning.V2AdaptableTx
+			})
+			if !ok {
+				return fmt.Errorf("unsupported transaction type %T", tx)
+			}
+			signers, err := sigTx.GetSigners()
+			if err != nil {
+				return err
+			}
+			if len(signers) != 1 {
+				return fmt.Errorf("EIP-712 signed transactions must have a single signer, got %d", len(signers))
+			}
+			signer := sdk.AccAddress(signers[0])
+
+			accNum, _ := cmd.Flags().GetUint64(flags.FlagAccountNumber)
+			sequence, _ := cmd.Flags().GetUint64(flags.FlagSequence)
+			if !clientCtx.Offline {
+				accNum, sequence, err = clientCtx.AccountRetriever.GetAccountNumberSequence(clientCtx, signer)
+				if err != nil {
+					return err
+				}
+			}
+
+			signerData := txsigning.SignerData{
+				Address:       signer.String(),
+				ChainID:       clientCtx.ChainID,
+				AccountNumber: accNum,
+				Sequence:      sequence,
+			}
+			typedData, err := app.NewEIP712SignModeHandler(clientCtx.InterfaceRegistry).GetTypedData(cmd.Context(), signerData, sigTx.GetSigningTxData())
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(typedData, "", "  ")
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintString(string(bz) + "\n")
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -95,7 +95,7 @@ func NewRootCmd() *cobra.Command {
 					TextualCoinMetadataQueryFn: txmodule.NewGRPCCoinMetadataQueryFn(initClientCtx),
 				}
 
-				txConfig, err := tx.NewTxConfigWithOptions(
+				txConfig, err := app.NewTxConfigWithOptions(
 					initClientCtx.Codec,
 					txConfigOpts,
 				)
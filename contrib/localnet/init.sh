@@ -24,8 +24,9 @@ sed -i.bak "s/\"no_base_fee\": false/\"no_base_fee\": true/g" $HOME/.tacchaind/c
 sed -i.bak "s/\"max_gas\": \"-1\"/\"max_gas\": \"20000000\"/g" $HOME/.tacchaind/config/genesis.json
 # enable evm eip-3855
 sed -i.bak "s/\"extra_eips\": \[\]/\"extra_eips\": \[\"3855\"\,\"5656\"\]/g" $HOME/.tacchaind/config/genesis.json
-# disable EIP-155
-sed -i.bak "s/\"allow_unprotected_txs\": false/\"allow_unprotected_txs\": true/g" $HOME/.tacchaind/config/genesis.json
+# allowlist the unprotected txs of the deployer of the CREATE2 factory (0x3fab184622dc19b6109349b94811493bf2a45362)
+# instead of disabling EIP-155 chain-wide, and accept unprotected txs on the JSON-RPC
+sed -i.bak "s/\"unprotected_senders\": \[\]/\"unprotected_senders\": \[\"tac18743s33zmsvmvyynfxu5sy2f80e2g5mzdfnwcs\"\]/g" $HOME/.tacchaind/config/genesis.json
 sed -i.bak "s/allow-unprotected-txs = false/\allow-unprotected-txs = true/g" $HOME/.tacchaind/config/app.toml
 
 
@@ -0,0 +1,102 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package blocklist
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/Asphere-xyz/tacchain/x/blocklist/keeper"
+	"github.com/Asphere-xyz/tacchain/x/blocklist/types"
+)
+
+var (
+	_ porttypes.IBCModule        = IBCMiddleware{}
+	_ porttypes.UpgradableModule = IBCMiddleware{}
+)
+
+// IBCMiddleware rejects the ICS-20 transfers received from or by blocked
+// addresses with an error acknowledgement, which refunds the sender on the
+// source chain.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+
+	keeper keeper.Keeper
+}
+
+// NewIBCMiddleware creates a new IBCMiddleware wrapping the transfer app.
+func NewIBCMiddleware(app porttypes.IBCModule, k keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface. The packets which cannot be
+// decoded are left to the transfer app to reject.
+func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) ibcexported.Acknowledgement {
+	var data transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(packet.GetData(), &data); err != nil {
+		return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+	}
+
+	for _, s := range []string{data.Sender, data.Receiver} {
+		addr, err := types.ParseAddress(s)
+		if err != nil {
+			// the sender is an address of the source chain, which may not be
+			// an account address
+			continue
+		}
+		blocked, err := im.keeper.IsBlocked(ctx, addr)
+		if err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+		if blocked {
+			return channeltypes.NewErrorAcknowledgement(errorsmod.Wrapf(types.ErrBlocked, "%s", s))
+		}
+	}
+
+	return im.IBCModule.OnRecvPacket(ctx, packet, relayer)
+}
+
+// OnChanUpgradeInit implements the UpgradableModule interface.
+func (im IBCMiddleware) OnChanUpgradeInit(ctx sdk.Context, portID, channelID string, proposedOrder channeltypes.Order, proposedConnectionHops []string, proposedVersion string) (string, error) {
+	cbs, ok := im.IBCModule.(porttypes.UpgradableModule)
+	if !ok {
+		return "", errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+	return cbs.OnChanUpgradeInit(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
+}
+
+// OnChanUpgradeTry implements the UpgradableModule interface.
+func (im IBCMiddleware) OnChanUpgradeTry(ctx sdk.Context, portID, channelID string, proposedOrder channeltypes.Order, proposedConnectionHops []string, counterpartyVersion string) (string, error) {
+	cbs, ok := im.IBCModule.(porttypes.UpgradableModule)
+	if !ok {
+		return "", errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+	return cbs.OnChanUpgradeTry(ctx, portID, channelID, proposedOrder, proposedConnectionHops, counterpartyVersion)
+}
+
+// OnChanUpgradeAck implements the UpgradableModule interface.
+func (im IBCMiddleware) OnChanUpgradeAck(ctx sdk.Context, portID, channelID, counterpartyVersion string) error {
+	cbs, ok := im.IBCModule.(porttypes.UpgradableModule)
+	if !ok {
+		return errorsmod.Wrap(porttypes.ErrInvalidRoute, "upgrade route not found to module in application callstack")
+	}
+	return cbs.OnChanUpgradeAck(ctx, portID, channelID, counterpartyVersion)
+}
+
+// OnChanUpgradeOpen implements the UpgradableModule interface.
+func (im IBCMiddleware) OnChanUpgradeOpen(ctx sdk.Context, portID, channelID string, proposedOrder channeltypes.Order, proposedConnectionHops []string, proposedVersion string) {
+	if cbs, ok := im.IBCModule.(porttypes.UpgradableModule); ok {
+		cbs.OnChanUpgradeOpen(ctx, portID, channelID, proposedOrder, proposedConnectionHops, proposedVersion)
+	}
+}
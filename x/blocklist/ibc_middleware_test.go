@@ -0,0 +1,90 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package blocklist_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+
+	"github.com/Asphere-xyz/tacchain/x/blocklist"
+	"github.com/Asphere-xyz/tacchain/x/blocklist/keeper"
+	"github.com/Asphere-xyz/tacchain/x/blocklist/types"
+)
+
+// mockTransferModule acknowledges every packet successfully.
+type mockTransferModule struct {
+	porttypes.IBCModule
+	received int
+}
+
+func (m *mockTransferModule) OnRecvPacket(_ sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) ibcexported.Acknowledgement {
+	m.received++
+	return channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+}
+
+func TestIBCMiddlewareOnRecvPacket(t *testing.T) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	k := keeper.NewKeeper(moduletestutil.MakeTestEncodingConfig().Codec, runtime.NewKVStoreService(key), nil, "authority")
+
+	blocked := common.HexToAddress("0x2000000000000000000000000000000000000001")
+	require.NoError(t, k.Blocked.Set(ctx, blocked.Bytes()))
+	other := sdk.AccAddress(common.HexToAddress("0x2000000000000000000000000000000000000002").Bytes())
+
+	cosmosAddr := func(addr []byte) string {
+		s, err := bech32.ConvertAndEncode("cosmos", addr)
+		require.NoError(t, err)
+		return s
+	}
+
+	app := &mockTransferModule{}
+	middleware := blocklist.NewIBCMiddleware(app, k)
+	newPacket := func(sender, receiver string) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData("uatom", "100", sender, receiver, "")
+		return channeltypes.Packet{Data: data.GetBytes()}
+	}
+
+	testCases := []struct {
+		name    string
+		packet  channeltypes.Packet
+		success bool
+	}{
+		{"allowed", newPacket(cosmosAddr(other), other.String()), true},
+		{"blocked receiver", newPacket(other.String(), sdk.AccAddress(blocked.Bytes()).String()), false},
+		{"blocked hex receiver", newPacket(other.String(), blocked.Hex()), false},
+		// the sender is blocked whatever its bech32 prefix
+		{"blocked sender", newPacket(cosmosAddr(blocked.Bytes()), other.String()), false},
+		{"non-account sender", newPacket("0xinvalid", other.String()), true},
+		{"undecodable packet", channeltypes.Packet{Data: []byte("invalid")}, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			received := app.received
+			ack := middleware.OnRecvPacket(ctx, tc.packet, nil)
+			require.Equal(t, tc.success, ack.Success())
+			if tc.success {
+				require.Equal(t, received+1, app.received)
+			} else {
+				require.Equal(t, received, app.received)
+			}
+		})
+	}
+}
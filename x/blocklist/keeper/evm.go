@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+// BlocklistAddress is the address of the account holding the blocked EVM
+// addresses in its storage, so that they can be read while executing EVM txs.
+// The blocked addresses are a mapping(address => bool) at slot 0, following the
+// Solidity storage layout.
+var BlocklistAddress = common.HexToAddress("0x0000000000000000000000000000000000000902")
+
+var (
+	blockedSlot = common.Hash{}
+	blocked     = common.BigToHash(big.NewInt(1))
+)
+
+// blockedKey returns the storage slot of addr in the blocked mapping.
+func blockedKey(addr common.Address) common.Hash {
+	return crypto.Keccak256Hash(common.LeftPadBytes(addr.Bytes(), 32), blockedSlot.Bytes())
+}
+
+// setEVMBlocked sets whether addr is blocked in the EVM state, creating the
+// blocklist account first if needed. The addresses that aren't EVM addresses,
+// such as the ones of CosmWasm contracts, are left out.
+func (k Keeper) setEVMBlocked(ctx sdk.Context, addr sdk.AccAddress, isBlocked bool) error {
+	if len(addr) != common.AddressLength {
+		return nil
+	}
+
+	if k.evmKeeper.GetAccount(ctx, BlocklistAddress) == nil {
+		if err := k.evmKeeper.SetAccount(ctx, BlocklistAddress, *statedb.NewEmptyAccount()); err != nil {
+			return err
+		}
+	}
+
+	var value []byte
+	if isBlocked {
+		value = blocked.Bytes()
+	}
+	k.evmKeeper.SetState(ctx, BlocklistAddress, blockedKey(common.BytesToAddress(addr)), value)
+	return nil
+}
+
+// isBlockedInEVM reports whether addr is blocked according to the EVM state.
+func isBlockedInEVM(db vm.StateDB, addr common.Address) bool {
+	return db.GetState(BlocklistAddress, blockedKey(addr)) == blocked
+}
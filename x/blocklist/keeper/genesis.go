@@ -0,0 +1,40 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Asphere-xyz/tacchain/x/blocklist/types"
+)
+
+// InitGenesis initializes the blocklist module's state from a given genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) error {
+	for _, s := range gs.Addresses {
+		addr, err := types.ParseAddress(s)
+		if err != nil {
+			return err
+		}
+		if err := k.Block(ctx, addr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportGenesis returns the blocklist module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) (*types.GenesisState, error) {
+	addresses := []string{}
+	err := k.Blocked.Walk(ctx, nil, func(addr []byte) (bool, error) {
+		addresses = append(addresses, sdk.AccAddress(addr).String())
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(addresses), nil
+}
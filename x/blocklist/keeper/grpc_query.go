@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/Asphere-xyz/tacchain/x/blocklist/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+type queryServer struct {
+	k Keeper
+}
+
+// NewQueryServerImpl returns an implementation of the QueryServer interface
+// for the provided Keeper.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k: k}
+}
+
+// Blocked returns whether an address is blocked.
+func (q queryServer) Blocked(ctx context.Context, req *types.QueryBlockedRequest) (*types.QueryBlockedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addr, err := types.ParseAddress(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	blocked, err := q.k.IsBlocked(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryBlockedResponse{Blocked: blocked}, nil
+}
+
+// Addresses returns all the blocked addresses.
+func (q queryServer) Addresses(ctx context.Context, req *types.QueryAddressesRequest) (*types.QueryAddressesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	addresses, pageRes, err := query.CollectionPaginate(ctx, q.k.Blocked, req.Pagination, func(addr []byte, _ collections.NoValue) (string, error) {
+		return sdk.AccAddress(addr).String(), nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryAddressesResponse{Addresses: addresses, Pagination: pageRes}, nil
+}
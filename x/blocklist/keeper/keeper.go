@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/Asphere-xyz/tacchain/x/blocklist/types"
+)
+
+// Keeper of the blocklist store
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+	evmKeeper    types.EVMKeeper
+	// exempt holds the addresses of the module accounts which may still send
+	// to blocked addresses
+	exempt map[string]bool
+
+	// the address capable of executing the MsgBlockAddresses and
+	// MsgUnblockAddresses messages. Typically, this should be the x/gov module
+	// account.
+	authority string
+
+	Schema collections.Schema
+	// Blocked holds the blocked addresses
+	Blocked collections.KeySet[[]byte]
+}
+
+// NewKeeper returns a new blocklist keeper. The accounts of the exempt modules
+// may still send to blocked addresses, see SendRestriction.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	exemptModules []string,
+	authority string,
+) Keeper {
+	exempt := make(map[string]bool, len(exemptModules))
+	for _, name := range exemptModules {
+		exempt[authtypes.NewModuleAddress(name).String()] = true
+	}
+
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		exempt:       exempt,
+		authority:    authority,
+		Blocked:      collections.NewKeySet(sb, types.BlockedKey, "blocked", collections.BytesKey),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// SetEVMKeeper sets the EVM keeper the blocklist is mirrored into. The EVM
+// keeper depends on the bank keeper, which is restricted by the blocklist
+// keeper, so it can only be set once both are created.
+func (k *Keeper) SetEVMKeeper(evmKeeper types.EVMKeeper) {
+	k.evmKeeper = evmKeeper
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// IsBlocked reports whether the address is blocked.
+func (k Keeper) IsBlocked(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	return k.Blocked.Has(ctx, addr)
+}
+
+// Block adds the address to the blocklist.
+func (k Keeper) Block(ctx context.Context, addr sdk.AccAddress) error {
+	if err := k.Blocked.Set(ctx, addr); err != nil {
+		return err
+	}
+	return k.setEVMBlocked(sdk.UnwrapSDKContext(ctx), addr, true)
+}
+
+// Unblock removes the address from the blocklist.
+func (k Keeper) Unblock(ctx context.Context, addr sdk.AccAddress) error {
+	if err := k.Blocked.Remove(ctx, addr); err != nil {
+		return err
+	}
+	return k.setEVMBlocked(sdk.UnwrapSDKContext(ctx), addr, false)
+}
+
+// SendRestriction is the bank send restriction rejecting the sends from and to
+// blocked addresses. The sends from the exempt module accounts to blocked
+// addresses are allowed, for the end blockers refunding deposits or releasing
+// unbonded funds not to fail. The other module accounts are restricted, among
+// which the EVM module crediting the balances changed by the EVM txs.
+func (k Keeper) SendRestriction(ctx context.Context, from, to sdk.AccAddress, _ sdk.Coins) (sdk.AccAddress, error) {
+	blocked, err := k.IsBlocked(ctx, from)
+	if err != nil {
+		return to, err
+	}
+	if blocked {
+		return to, errorsmod.Wrapf(types.ErrBlocked, "sender %s", from)
+	}
+
+	blocked, err = k.IsBlocked(ctx, to)
+	if err != nil {
+		return to, err
+	}
+	if blocked && !k.exempt[from.String()] {
+		return to, errorsmod.Wrapf(types.ErrBlocked, "recipient %s", to)
+	}
+	return to, nil
+}
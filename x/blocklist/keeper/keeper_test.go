@@ -0,0 +1,153 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/Asphere-xyz/tacchain/x/blocklist/keeper"
+	"github.com/Asphere-xyz/tacchain/x/blocklist/types"
+)
+
+// mockEVMKeeper keeps the EVM accounts and storage in memory.
+type mockEVMKeeper struct {
+	accounts map[common.Address]statedb.Account
+	storage  map[common.Hash]common.Hash
+}
+
+var _ types.EVMKeeper = (*mockEVMKeeper)(nil)
+
+func (m *mockEVMKeeper) GetAccount(_ sdk.Context, addr common.Address) *statedb.Account {
+	acc, ok := m.accounts[addr]
+	if !ok {
+		return nil
+	}
+	return &acc
+}
+
+func (m *mockEVMKeeper) SetAccount(_ sdk.Context, addr common.Address, account statedb.Account) error {
+	m.accounts[addr] = account
+	return nil
+}
+
+func (m *mockEVMKeeper) SetState(_ sdk.Context, addr common.Address, key common.Hash, value []byte) {
+	if addr != keeper.BlocklistAddress {
+		panic("unexpected address")
+	}
+	if len(value) == 0 {
+		delete(m.storage, key)
+		return
+	}
+	m.storage[key] = common.BytesToHash(value)
+}
+
+var (
+	blockedAddr = sdk.AccAddress(common.HexToAddress("0x2000000000000000000000000000000000000001").Bytes())
+	otherAddr   = sdk.AccAddress(common.HexToAddress("0x2000000000000000000000000000000000000002").Bytes())
+	moduleAddr  = authtypes.NewModuleAddress(govtypes.ModuleName)
+)
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper, *mockEVMKeeper) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+
+	evmKeeper := &mockEVMKeeper{accounts: map[common.Address]statedb.Account{}, storage: map[common.Hash]common.Hash{}}
+	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(key), []string{govtypes.ModuleName}, "authority")
+	k.SetEVMKeeper(evmKeeper)
+	return ctx, k, evmKeeper
+}
+
+func TestBlockAddresses(t *testing.T) {
+	ctx, k, evmKeeper := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+	queryServer := keeper.NewQueryServerImpl(k)
+
+	// the hex address is the same as the bech32 one
+	hexAddr := common.BytesToAddress(blockedAddr).Hex()
+	_, err := msgServer.BlockAddresses(ctx, types.NewMsgBlockAddresses("not authority", []string{hexAddr}))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = msgServer.BlockAddresses(ctx, types.NewMsgBlockAddresses("authority", []string{"invalid"}))
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+
+	_, err = msgServer.BlockAddresses(ctx, types.NewMsgBlockAddresses("authority", []string{hexAddr}))
+	require.NoError(t, err)
+	// the blocked address is mirrored into the EVM state
+	require.NotNil(t, evmKeeper.GetAccount(ctx, keeper.BlocklistAddress))
+	require.Len(t, evmKeeper.storage, 1)
+
+	for _, addr := range []string{hexAddr, blockedAddr.String()} {
+		res, err := queryServer.Blocked(ctx, &types.QueryBlockedRequest{Address: addr})
+		require.NoError(t, err)
+		require.True(t, res.Blocked, addr)
+	}
+	res, err := queryServer.Blocked(ctx, &types.QueryBlockedRequest{Address: otherAddr.String()})
+	require.NoError(t, err)
+	require.False(t, res.Blocked)
+
+	addrs, err := queryServer.Addresses(ctx, &types.QueryAddressesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{blockedAddr.String()}, addrs.Addresses)
+
+	gs, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.NewGenesisState([]string{blockedAddr.String()}), gs)
+
+	_, err = msgServer.UnblockAddresses(ctx, types.NewMsgUnblockAddresses("not authority", []string{blockedAddr.String()}))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = msgServer.UnblockAddresses(ctx, types.NewMsgUnblockAddresses("authority", []string{blockedAddr.String()}))
+	require.NoError(t, err)
+
+	blocked, err := k.IsBlocked(ctx, blockedAddr)
+	require.NoError(t, err)
+	require.False(t, blocked)
+	require.Empty(t, evmKeeper.storage)
+}
+
+func TestSendRestriction(t *testing.T) {
+	ctx, k, _ := setupKeeper(t)
+	require.NoError(t, k.Block(ctx, blockedAddr))
+
+	_, err := k.SendRestriction(ctx, otherAddr, moduleAddr, nil)
+	require.NoError(t, err)
+
+	_, err = k.SendRestriction(ctx, blockedAddr, otherAddr, nil)
+	require.ErrorIs(t, err, types.ErrBlocked)
+	_, err = k.SendRestriction(ctx, otherAddr, blockedAddr, nil)
+	require.ErrorIs(t, err, types.ErrBlocked)
+
+	// the exempt module accounts can still send to blocked addresses, but not
+	// the EVM module crediting the balances changed by the EVM txs
+	_, err = k.SendRestriction(ctx, moduleAddr, blockedAddr, nil)
+	require.NoError(t, err)
+	_, err = k.SendRestriction(ctx, authtypes.NewModuleAddress(evmtypes.ModuleName), blockedAddr, nil)
+	require.ErrorIs(t, err, types.ErrBlocked)
+}
+
+func TestGenesisValidate(t *testing.T) {
+	hexAddr := common.BytesToAddress(blockedAddr).Hex()
+
+	require.NoError(t, types.DefaultGenesisState().Validate())
+	require.NoError(t, types.NewGenesisState([]string{hexAddr, otherAddr.String()}).Validate())
+	require.Error(t, types.NewGenesisState([]string{"invalid"}).Validate())
+	require.Error(t, types.NewGenesisState([]string{hexAddr, blockedAddr.String()}).Validate())
+}
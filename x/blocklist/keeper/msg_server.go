@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/Asphere-xyz/tacchain/x/blocklist/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	k Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface for
+// the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return msgServer{k: k}
+}
+
+// BlockAddresses adds addresses to the blocklist.
+func (m msgServer) BlockAddresses(ctx context.Context, msg *types.MsgBlockAddresses) (*types.MsgBlockAddressesResponse, error) {
+	if m.k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.k.authority, msg.Authority)
+	}
+
+	for _, s := range msg.Addresses {
+		addr, err := types.ParseAddress(s)
+		if err != nil {
+			return nil, err
+		}
+		if err := m.k.Block(ctx, addr); err != nil {
+			return nil, err
+		}
+	}
+	return &types.MsgBlockAddressesResponse{}, nil
+}
+
+// UnblockAddresses removes addresses from the blocklist.
+func (m msgServer) UnblockAddresses(ctx context.Context, msg *types.MsgUnblockAddresses) (*types.MsgUnblockAddressesResponse, error) {
+	if m.k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.k.authority, msg.Authority)
+	}
+
+	for _, s := range msg.Addresses {
+		addr, err := types.ParseAddress(s)
+		if err != nil {
+			return nil, err
+		}
+		if err := m.k.Unblock(ctx, addr); err != nil {
+			return nil, err
+		}
+	}
+	return &types.MsgUnblockAddressesResponse{}, nil
+}
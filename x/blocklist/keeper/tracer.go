@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	evm "github.com/evmos/ethermint/x/evm/vm"
+)
+
+// NewEVMConstructor wraps an EVM constructor to reject the transfers of value
+// from or to blocked addresses made by contracts, such as a call{value:} to a
+// blocked address, which the ante handler only checks at the top level of the
+// txs. It applies to every EVM tx, whether it's an Ethereum tx or is executed
+// on behalf of a Cosmos tx.
+//
+// The transfers are rejected by a tracer wrapping the one of the EVM. Ethermint
+// always sets a tracer, so this doesn't add a code path to the interpreter.
+func NewEVMConstructor(next evm.Constructor) evm.Constructor {
+	return func(
+		blockCtx vm.BlockContext,
+		txCtx vm.TxContext,
+		stateDB vm.StateDB,
+		chainConfig *params.ChainConfig,
+		config vm.Config,
+		customPrecompiles evm.PrecompiledContracts,
+	) evm.EVM {
+		tracer := config.Tracer
+		if tracer == nil {
+			tracer = evmtypes.NewNoOpTracer()
+		}
+		config.Tracer = &transferGuard{EVMLogger: tracer, stateDB: stateDB}
+		return next(blockCtx, txCtx, stateDB, chainConfig, config, customPrecompiles)
+	}
+}
+
+// transferGuard is a tracer that makes the call frames transferring value from
+// or to blocked addresses fail, and forwards every call to the wrapped tracer.
+//
+// A tracer can't return an error, and the recipient of a transfer may have no
+// code to run, so the guard takes the remaining gas of the frame making the
+// transfer at its next opcode instead. This fails it with out of gas and
+// reverts its state, including the transfer. A frame that stops right after
+// the transfer, or a transfer by the tx itself to a blocked account without
+// code, is left alone: the balance change of the blocked address is then
+// rejected by the bank send restriction when the EVM state is committed, which
+// fails the whole tx.
+type transferGuard struct {
+	vm.EVMLogger
+
+	stateDB vm.StateDB
+	// depth is the call depth of the last opcode
+	depth int
+	// blocked is the call depth of the outermost frame that made a transfer
+	// from or to a blocked address, until its next opcode, or zero
+	blocked int
+}
+
+func (g *transferGuard) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	g.depth, g.blocked = 0, 0
+	// the tx itself runs at depth 1
+	g.check(1, from, to, value)
+	g.EVMLogger.CaptureStart(env, from, to, create, input, gas, value)
+}
+
+func (g *transferGuard) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	// the frame making the transfer runs the opcode entering the new frame
+	g.check(g.depth, from, to, value)
+	g.EVMLogger.CaptureEnter(typ, from, to, input, gas, value)
+}
+
+func (g *transferGuard) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	g.depth = depth
+	if g.blocked != 0 && depth <= g.blocked {
+		scope.Contract.Gas = 0
+		g.blocked = 0
+	}
+	g.EVMLogger.CaptureState(pc, op, gas, cost, scope, rData, depth, err)
+}
+
+// check fails the frame at depth if it transfers value from or to a blocked
+// address.
+func (g *transferGuard) check(depth int, from, to common.Address, value *big.Int) {
+	if value == nil || value.Sign() == 0 {
+		return
+	}
+	if !isBlockedInEVM(g.stateDB, from) && !isBlockedInEVM(g.stateDB, to) {
+		return
+	}
+	if g.blocked == 0 || depth < g.blocked {
+		g.blocked = depth
+	}
+}
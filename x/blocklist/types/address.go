@@ -0,0 +1,32 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+)
+
+// ParseAddress parses an address in hex or in bech32, with any prefix, so that
+// the forms of an address on the EVM, on TAC and on the chains connected over
+// IBC are the same address.
+func ParseAddress(s string) (sdk.AccAddress, error) {
+	if common.IsHexAddress(s) {
+		return common.HexToAddress(s).Bytes(), nil
+	}
+
+	_, bz, err := bech32.DecodeAndConvert(s)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidAddress, "%q is neither a hex nor a bech32 address", s)
+	}
+	if err := sdk.VerifyAddressFormat(bz); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidAddress, "%s: %s", s, err)
+	}
+	return bz, nil
+}
@@ -0,0 +1,20 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+)
+
+// EVMKeeper defines the EVM state methods used to mirror the blocklist into
+// the EVM state.
+type EVMKeeper interface {
+	GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account
+	SetAccount(ctx sdk.Context, addr common.Address, account statedb.Account) error
+	SetState(ctx sdk.Context, addr common.Address, key common.Hash, value []byte)
+}
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(addresses []string) *GenesisState {
+	return &GenesisState{
+		Addresses: addresses,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the blocklist
+// module, with no blocked addresses.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]string{})
+}
+
+// Validate performs a basic validation of the genesis state.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]struct{}, len(gs.Addresses))
+	for _, s := range gs.Addresses {
+		addr, err := ParseAddress(s)
+		if err != nil {
+			return err
+		}
+		if _, ok := seen[string(addr)]; ok {
+			return fmt.Errorf("duplicate blocked address %s", s)
+		}
+		seen[string(addr)] = struct{}{}
+	}
+	return nil
+}
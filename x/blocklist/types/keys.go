@@ -0,0 +1,18 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "blocklist"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+// BlockedKey is the prefix of the blocked addresses
+var BlockedKey = collections.NewPrefix(0)
@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+var (
+	_ sdk.Msg = &MsgBlockAddresses{}
+	_ sdk.Msg = &MsgUnblockAddresses{}
+)
+
+// NewMsgBlockAddresses creates a new MsgBlockAddresses instance.
+func NewMsgBlockAddresses(authority string, addresses []string) *MsgBlockAddresses {
+	return &MsgBlockAddresses{
+		Authority: authority,
+		Addresses: addresses,
+	}
+}
+
+// NewMsgUnblockAddresses creates a new MsgUnblockAddresses instance.
+func NewMsgUnblockAddresses(authority string, addresses []string) *MsgUnblockAddresses {
+	return &MsgUnblockAddresses{
+		Authority: authority,
+		Addresses: addresses,
+	}
+}
@@ -0,0 +1,68 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+
+	"github.com/Asphere-xyz/tacchain/x/deployer/types"
+)
+
+// PolicyAddress is the address of the account holding the deployment policy
+// in its storage, so that it can be read while executing EVM txs. Slot 0 holds
+// the access type and the allowlist is a mapping(address => bool) at slot 1,
+// following the Solidity storage layout.
+var PolicyAddress = common.HexToAddress("0x0000000000000000000000000000000000000901")
+
+var (
+	accessTypeSlot = common.Hash{}
+	allowlistSlot  = common.BigToHash(big.NewInt(1))
+	allowed        = common.BigToHash(big.NewInt(1))
+)
+
+// allowlistKey returns the storage slot of addr in the allowlist mapping.
+func allowlistKey(addr common.Address) common.Hash {
+	return crypto.Keccak256Hash(common.LeftPadBytes(addr.Bytes(), 32), allowlistSlot.Bytes())
+}
+
+// setEVMPolicy replaces the deployment policy of old params in the EVM state
+// with the one of params, creating the policy account first if needed.
+func (k Keeper) setEVMPolicy(ctx sdk.Context, old, params types.Params) error {
+	if k.evmKeeper.GetAccount(ctx, PolicyAddress) == nil {
+		if err := k.evmKeeper.SetAccount(ctx, PolicyAddress, *statedb.NewEmptyAccount()); err != nil {
+			return err
+		}
+	}
+
+	for _, addr := range old.AllowlistAddresses() {
+		k.evmKeeper.SetState(ctx, PolicyAddress, allowlistKey(addr), nil)
+	}
+	for _, addr := range params.AllowlistAddresses() {
+		k.evmKeeper.SetState(ctx, PolicyAddress, allowlistKey(addr), allowed.Bytes())
+	}
+	k.evmKeeper.SetState(ctx, PolicyAddress, accessTypeSlot, common.BigToHash(big.NewInt(int64(params.AccessType))).Bytes())
+	return nil
+}
+
+// canDeploy reports whether addr may deploy contracts according to the policy
+// in the EVM state. The policy is only missing before the module is
+// initialized, in which case anybody may deploy, as before the module existed.
+func canDeploy(db vm.StateDB, addr common.Address) bool {
+	switch types.AccessType(db.GetState(PolicyAddress, accessTypeSlot).Big().Int64()) {
+	case types.AccessTypeUnspecified, types.AccessTypeEverybody:
+		return true
+	case types.AccessTypeAllowlist:
+		return db.GetState(PolicyAddress, allowlistKey(addr)) == allowed
+	default:
+		return false
+	}
+}
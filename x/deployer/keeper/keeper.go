@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Asphere-xyz/tacchain/x/deployer/types"
+)
+
+// Keeper of the deployer store
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+	evmKeeper    types.EVMKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically,
+	// this should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+}
+
+// NewKeeper returns a new deployer keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	evmKeeper types.EVMKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		evmKeeper:    evmKeeper,
+		authority:    authority,
+		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// SetParams sets the module parameters and mirrors the deployment policy into
+// the EVM state, where it's enforced for CREATE and CREATE2.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	old, err := k.Params.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err := k.Params.Set(ctx, params); err != nil {
+		return err
+	}
+	return k.setEVMPolicy(ctx, old, params)
+}
+
+// CanDeploy reports whether addr may deploy contracts.
+func (k Keeper) CanDeploy(ctx sdk.Context, addr common.Address) (bool, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+	return params.CanDeploy(addr), nil
+}
+
+// IsUnprotectedTxAllowed reports whether the unprotected (pre-EIP-155) tx with
+// the given hash and sender is allowlisted.
+func (k Keeper) IsUnprotectedTxAllowed(ctx sdk.Context, hash common.Hash, sender common.Address) (bool, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false, err
+	}
+	return params.AllowsUnprotectedTx(hash, sender), nil
+}
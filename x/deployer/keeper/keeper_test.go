@@ -0,0 +1,182 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+
+	"github.com/Asphere-xyz/tacchain/x/deployer/keeper"
+	"github.com/Asphere-xyz/tacchain/x/deployer/types"
+)
+
+var (
+	alice   = common.HexToAddress("0x00000000000000000000000000000000000a11ce")
+	bob     = common.HexToAddress("0x0000000000000000000000000000000000000b0b")
+	factory = common.HexToAddress("0x0000000000000000000000000000000000fac702")
+)
+
+// mockEVMKeeper keeps the EVM accounts and storage in memory.
+type mockEVMKeeper struct {
+	accounts map[common.Address]statedb.Account
+	storage  map[common.Address]map[common.Hash]common.Hash
+}
+
+var _ types.EVMKeeper = (*mockEVMKeeper)(nil)
+
+func newMockEVMKeeper() *mockEVMKeeper {
+	return &mockEVMKeeper{
+		accounts: make(map[common.Address]statedb.Account),
+		storage:  make(map[common.Address]map[common.Hash]common.Hash),
+	}
+}
+
+func (m *mockEVMKeeper) GetAccount(_ sdk.Context, addr common.Address) *statedb.Account {
+	acc, ok := m.accounts[addr]
+	if !ok {
+		return nil
+	}
+	return &acc
+}
+
+func (m *mockEVMKeeper) SetAccount(_ sdk.Context, addr common.Address, account statedb.Account) error {
+	m.accounts[addr] = account
+	return nil
+}
+
+func (m *mockEVMKeeper) SetState(_ sdk.Context, addr common.Address, key common.Hash, value []byte) {
+	if m.storage[addr] == nil {
+		m.storage[addr] = make(map[common.Hash]common.Hash)
+	}
+	if len(value) == 0 {
+		delete(m.storage[addr], key)
+		return
+	}
+	m.storage[addr][key] = common.BytesToHash(value)
+}
+
+func setupKeeper(t *testing.T, params types.Params) (sdk.Context, keeper.Keeper, *mockEVMKeeper) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+
+	evmKeeper := newMockEVMKeeper()
+	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(key), evmKeeper, "authority")
+	require.NoError(t, k.SetParams(ctx, params))
+	return ctx, k, evmKeeper
+}
+
+// bech32 returns the bech32 address of addr.
+func bech32(addr common.Address) string {
+	return sdk.AccAddress(addr.Bytes()).String()
+}
+
+func TestUpdateParams(t *testing.T) {
+	ctx, k, evmKeeper := setupKeeper(t, types.NewParams(types.AccessTypeAllowlist, []string{bech32(alice), bech32(bob)}))
+	msgServer := keeper.NewMsgServerImpl(k)
+	require.Len(t, evmKeeper.storage[keeper.PolicyAddress], 3)
+
+	params := types.NewParams(types.AccessTypeAllowlist, []string{bech32(bob)})
+	_, err := msgServer.UpdateParams(ctx, types.NewMsgUpdateParams("not authority", params))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	for _, invalid := range []types.Params{
+		types.NewParams(types.AccessTypeUnspecified, nil),
+		types.NewParams(types.AccessType(4), nil),
+		types.NewParams(types.AccessTypeEverybody, []string{bech32(bob)}),
+		types.NewParams(types.AccessTypeNobody, []string{bech32(bob)}),
+		types.NewParams(types.AccessTypeAllowlist, nil),
+		types.NewParams(types.AccessTypeAllowlist, []string{bob.Hex()}),
+		types.NewParams(types.AccessTypeAllowlist, []string{bech32(bob), bech32(bob)}),
+	} {
+		_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams("authority", invalid))
+		require.ErrorIs(t, err, types.ErrInvalidParams, invalid)
+	}
+
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams("authority", params))
+	require.NoError(t, err)
+
+	res, err := keeper.NewQueryServerImpl(k).Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, res.Params)
+
+	// the addresses removed from the allowlist are removed from the EVM state
+	require.Len(t, evmKeeper.storage[keeper.PolicyAddress], 2)
+
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams("authority", types.NewParams(types.AccessTypeNobody, nil)))
+	require.NoError(t, err)
+	require.Len(t, evmKeeper.storage[keeper.PolicyAddress], 1)
+}
+
+func TestQueryCanDeploy(t *testing.T) {
+	ctx, k, _ := setupKeeper(t, types.NewParams(types.AccessTypeAllowlist, []string{bech32(alice)}))
+	queryServer := keeper.NewQueryServerImpl(k)
+
+	for _, tc := range []struct {
+		address string
+		allowed bool
+	}{
+		{bech32(alice), true},
+		{alice.Hex(), true},
+		{bech32(bob), false},
+		{bob.Hex(), false},
+	} {
+		res, err := queryServer.CanDeploy(ctx, &types.QueryCanDeployRequest{Address: tc.address})
+		require.NoError(t, err)
+		require.Equal(t, tc.allowed, res.Allowed, tc.address)
+	}
+
+	_, err := queryServer.CanDeploy(ctx, &types.QueryCanDeployRequest{Address: "invalid"})
+	require.Error(t, err)
+	_, err = queryServer.CanDeploy(ctx, &types.QueryCanDeployRequest{})
+	require.Error(t, err)
+}
+
+func TestIsUnprotectedTxAllowed(t *testing.T) {
+	hash := common.HexToHash("0xabcdef0000000000000000000000000000000000000000000000000000000001")
+	other := common.HexToHash("0xaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa")
+	params := types.DefaultParams()
+	params.UnprotectedTxHashes = []string{hash.Hex()}
+	params.UnprotectedSenders = []string{bech32(alice)}
+	ctx, k, _ := setupKeeper(t, params)
+
+	for _, tc := range []struct {
+		hash    common.Hash
+		sender  common.Address
+		allowed bool
+	}{
+		{hash, bob, true},
+		{other, alice, true},
+		{other, bob, false},
+	} {
+		allowed, err := k.IsUnprotectedTxAllowed(ctx, tc.hash, tc.sender)
+		require.NoError(t, err)
+		require.Equal(t, tc.allowed, allowed)
+	}
+
+	for _, invalid := range []types.Params{
+		{AccessType: types.AccessTypeEverybody, UnprotectedTxHashes: []string{"0x1234"}},
+		{AccessType: types.AccessTypeEverybody, UnprotectedTxHashes: []string{hash.Hex()[2:]}},
+		{AccessType: types.AccessTypeEverybody, UnprotectedTxHashes: []string{hash.Hex(), "0x" + strings.ToUpper(hash.Hex()[2:])}},
+		{AccessType: types.AccessTypeEverybody, UnprotectedSenders: []string{alice.Hex()}},
+		{AccessType: types.AccessTypeEverybody, UnprotectedSenders: []string{bech32(alice), bech32(alice)}},
+	} {
+		require.Error(t, invalid.Validate(), invalid)
+	}
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	evm "github.com/evmos/ethermint/x/evm/vm"
+)
+
+// NewEVMConstructor wraps an EVM constructor to enforce the deployment policy
+// on the contracts created by txs, with CREATE and CREATE2. It applies to every
+// EVM tx, whether it's an Ethereum tx or is executed on behalf of a Cosmos tx,
+// as well as to eth_call and gas estimation.
+//
+// The policy is enforced by a tracer wrapping the one of the EVM. Ethermint
+// always sets a tracer, so this doesn't add a code path to the interpreter.
+func NewEVMConstructor(next evm.Constructor) evm.Constructor {
+	return func(
+		blockCtx vm.BlockContext,
+		txCtx vm.TxContext,
+		stateDB vm.StateDB,
+		chainConfig *params.ChainConfig,
+		config vm.Config,
+		customPrecompiles evm.PrecompiledContracts,
+	) evm.EVM {
+		tracer := config.Tracer
+		if tracer == nil {
+			tracer = evmtypes.NewNoOpTracer()
+		}
+		config.Tracer = &deployGuard{EVMLogger: tracer, stateDB: stateDB}
+		return next(blockCtx, txCtx, stateDB, chainConfig, config, customPrecompiles)
+	}
+}
+
+// deployGuard is a tracer that makes the creation of contracts fail when the
+// deployer is not allowed to deploy them, and forwards every call to the
+// wrapped tracer.
+//
+// A tracer can't return an error, so the guard takes the remaining gas of the
+// creation at its first opcode instead, which fails it with out of gas and
+// reverts its state, the same way as any failed creation. Init code that stops
+// at its first opcode can't deploy code and is left alone.
+type deployGuard struct {
+	vm.EVMLogger
+
+	stateDB vm.StateDB
+	// blocked is the address of the contract being created by a deployer that
+	// isn't allowed, until its first opcode
+	blocked *common.Address
+}
+
+func (g *deployGuard) CaptureStart(env *vm.EVM, from common.Address, to common.Address, create bool, input []byte, gas uint64, value *big.Int) {
+	if create {
+		g.check(from, to)
+	}
+	g.EVMLogger.CaptureStart(env, from, to, create, input, gas, value)
+}
+
+func (g *deployGuard) CaptureEnd(output []byte, gasUsed uint64, err error) {
+	g.blocked = nil
+	g.EVMLogger.CaptureEnd(output, gasUsed, err)
+}
+
+func (g *deployGuard) CaptureEnter(typ vm.OpCode, from common.Address, to common.Address, input []byte, gas uint64, value *big.Int) {
+	if typ == vm.CREATE || typ == vm.CREATE2 {
+		g.check(from, to)
+	}
+	g.EVMLogger.CaptureEnter(typ, from, to, input, gas, value)
+}
+
+func (g *deployGuard) CaptureExit(output []byte, gasUsed uint64, err error) {
+	g.blocked = nil
+	g.EVMLogger.CaptureExit(output, gasUsed, err)
+}
+
+func (g *deployGuard) CaptureState(pc uint64, op vm.OpCode, gas, cost uint64, scope *vm.ScopeContext, rData []byte, depth int, err error) {
+	if g.blocked != nil && scope.Contract.Address() == *g.blocked {
+		scope.Contract.Gas = 0
+		g.blocked = nil
+	}
+	g.EVMLogger.CaptureState(pc, op, gas, cost, scope, rData, depth, err)
+}
+
+// check blocks the creation of contract if deployer isn't allowed to deploy
+// contracts.
+func (g *deployGuard) check(deployer, contract common.Address) {
+	if !canDeploy(g.stateDB, deployer) {
+		g.blocked = &contract
+	}
+}
@@ -0,0 +1,98 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/holiman/uint256"
+	"github.com/stretchr/testify/require"
+
+	evm "github.com/evmos/ethermint/x/evm/vm"
+	"github.com/evmos/ethermint/x/evm/vm/geth"
+
+	"github.com/Asphere-xyz/tacchain/x/deployer/keeper"
+	"github.com/Asphere-xyz/tacchain/x/deployer/types"
+)
+
+var (
+	// initCode deploys a contract whose code is a single STOP.
+	initCode = common.FromHex("60016000f3")
+	// factoryCode creates a contract with initCode and returns its address.
+	factoryCode = common.FromHex("6460016000f3600052" + "6005601b6000f0" + "60005260206000f3")
+)
+
+// newTestEVM returns an EVM guarded by the deployment policy of p, along with
+// its state.
+func newTestEVM(t *testing.T, p types.Params) (evm.EVM, *state.StateDB) {
+	t.Helper()
+
+	_, _, evmKeeper := setupKeeper(t, p)
+	stateDB, err := state.New(ethtypes.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	require.NoError(t, err)
+	for key, value := range evmKeeper.storage[keeper.PolicyAddress] {
+		stateDB.SetState(keeper.PolicyAddress, key, value)
+	}
+	stateDB.SetCode(factory, factoryCode)
+
+	blockCtx := vm.BlockContext{
+		CanTransfer: core.CanTransfer,
+		Transfer:    core.Transfer,
+		BlockNumber: big.NewInt(1),
+		Random:      &common.Hash{},
+	}
+	return keeper.NewEVMConstructor(geth.NewEVM)(blockCtx, vm.TxContext{}, stateDB, params.TestChainConfig, vm.Config{}, nil), stateDB
+}
+
+func TestDeployGuard(t *testing.T) {
+	for _, tc := range []struct {
+		name     string
+		params   types.Params
+		deployer common.Address
+		// create reports whether the deployer may create a contract
+		create bool
+		// factory reports whether the factory may create a contract
+		factory bool
+	}{
+		{"everybody", types.DefaultParams(), bob, true, true},
+		{"allowed deployer", types.NewParams(types.AccessTypeAllowlist, []string{bech32(alice)}), alice, true, false},
+		{"not allowed deployer", types.NewParams(types.AccessTypeAllowlist, []string{bech32(alice)}), bob, false, false},
+		{"allowed factory", types.NewParams(types.AccessTypeAllowlist, []string{bech32(bob), bech32(factory)}), alice, false, true},
+		{"nobody", types.NewParams(types.AccessTypeNobody, nil), alice, false, false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			evm, stateDB := newTestEVM(t, tc.params)
+
+			_, contract, leftOverGas, err := evm.Create(vm.AccountRef(tc.deployer), initCode, 100_000, uint256.NewInt(0))
+			if tc.create {
+				require.NoError(t, err)
+				require.Equal(t, []byte{0}, stateDB.GetCode(contract))
+			} else {
+				require.ErrorIs(t, err, vm.ErrOutOfGas)
+				require.Zero(t, leftOverGas)
+				require.Empty(t, stateDB.GetCode(contract))
+			}
+
+			// a failed CREATE returns the zero address to the factory
+			ret, _, err := evm.Call(vm.AccountRef(tc.deployer), factory, nil, 100_000, uint256.NewInt(0))
+			require.NoError(t, err)
+			contract = common.BytesToAddress(ret)
+			if tc.factory {
+				require.NotEqual(t, common.Address{}, contract)
+				require.Equal(t, []byte{0}, stateDB.GetCode(contract))
+			} else {
+				require.Equal(t, common.Address{}, contract)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/x/evm/statedb"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxNonces is the maximum length of the chain of CREATE calls from a deployer
+// account to a contract it deployed.
+const MaxNonces = 20
+
+// EVMAccountKeeper returns the EVM accounts.
+type EVMAccountKeeper interface {
+	GetAccount(ctx sdk.Context, addr common.Address) *statedb.Account
+}
+
+// CheckDeployer checks that deployer deployed the EVM contract through the
+// chain of CREATE calls with the given nonces, the first one being a nonce of
+// the deployer. The errors wrap errInvalid if contract is not a contract or the
+// nonces are invalid, and errUnauthorized if deployer did not deploy it, so
+// that the modules using it return their own errors.
+func CheckDeployer(ctx sdk.Context, ek EVMAccountKeeper, contract common.Address, deployer sdk.AccAddress, nonces []uint64, errInvalid, errUnauthorized *errorsmod.Error) error {
+	if account := ek.GetAccount(ctx, contract); account == nil || !account.IsContract() {
+		return errorsmod.Wrapf(errInvalid, "%s is not a contract", contract)
+	}
+	if len(nonces) == 0 || len(nonces) > MaxNonces {
+		return errorsmod.Wrapf(errInvalid, "expected between 1 and %d nonces, got %d", MaxNonces, len(nonces))
+	}
+
+	if len(deployer) != common.AddressLength {
+		return errorsmod.Wrapf(errUnauthorized, "%s did not deploy contract %s", deployer, contract)
+	}
+	created := common.BytesToAddress(deployer)
+	for _, nonce := range nonces {
+		created = crypto.CreateAddress(created, nonce)
+	}
+	if created != contract {
+		return errorsmod.Wrapf(errUnauthorized, "%s did not deploy contract %s", deployer, contract)
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params) *GenesisState {
+	return &GenesisState{
+		Params: params,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the deployer
+// module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams())
+}
+
+// Validate performs a basic validation of the genesis state.
+func (gs GenesisState) Validate() error {
+	return gs.Params.Validate()
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+)
+
+// NewParams creates a new Params instance.
+func NewParams(accessType AccessType, allowlist []string) Params {
+	return Params{
+		AccessType: accessType,
+		Allowlist:  allowlist,
+	}
+}
+
+// DefaultParams returns the default deployer parameters, which allow anybody
+// to deploy contracts.
+func DefaultParams() Params {
+	return NewParams(AccessTypeEverybody, nil)
+}
+
+// Validate performs a basic validation of the deployer parameters. The
+// allowlist must only be set, and not be empty, with ACCESS_TYPE_ALLOWLIST,
+// and the unprotected txs must be hex encoded tx hashes.
+func (p Params) Validate() error {
+	switch p.AccessType {
+	case AccessTypeEverybody, AccessTypeNobody:
+		if len(p.Allowlist) > 0 {
+			return fmt.Errorf("allowlist must be empty with access type %s", p.AccessType)
+		}
+	case AccessTypeAllowlist:
+		if len(p.Allowlist) == 0 {
+			return fmt.Errorf("allowlist must not be empty with access type %s", p.AccessType)
+		}
+	default:
+		return fmt.Errorf("invalid access type %s", p.AccessType)
+	}
+
+	seen := make(map[string]struct{}, len(p.Allowlist))
+	for _, addr := range p.Allowlist {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid allowlist address %s: %w", addr, err)
+		}
+		if _, ok := seen[addr]; ok {
+			return fmt.Errorf("duplicate allowlist address %s", addr)
+		}
+		seen[addr] = struct{}{}
+	}
+
+	seenHashes := make(map[common.Hash]struct{}, len(p.UnprotectedTxHashes))
+	for _, hash := range p.UnprotectedTxHashes {
+		bz, err := hexutil.Decode(hash)
+		if err != nil || len(bz) != common.HashLength {
+			return fmt.Errorf("invalid unprotected tx hash %s", hash)
+		}
+		if _, ok := seenHashes[common.BytesToHash(bz)]; ok {
+			return fmt.Errorf("duplicate unprotected tx hash %s", hash)
+		}
+		seenHashes[common.BytesToHash(bz)] = struct{}{}
+	}
+
+	seenSenders := make(map[string]struct{}, len(p.UnprotectedSenders))
+	for _, addr := range p.UnprotectedSenders {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return fmt.Errorf("invalid unprotected sender %s: %w", addr, err)
+		}
+		if _, ok := seenSenders[addr]; ok {
+			return fmt.Errorf("duplicate unprotected sender %s", addr)
+		}
+		seenSenders[addr] = struct{}{}
+	}
+	return nil
+}
+
+// AllowlistAddresses returns the allowlist as EVM addresses. It assumes the
+// params are valid.
+func (p Params) AllowlistAddresses() []common.Address {
+	addrs := make([]common.Address, 0, len(p.Allowlist))
+	for _, addr := range p.Allowlist {
+		addrs = append(addrs, common.BytesToAddress(sdk.MustAccAddressFromBech32(addr)))
+	}
+	return addrs
+}
+
+// CanDeploy reports whether addr may deploy contracts.
+func (p Params) CanDeploy(addr common.Address) bool {
+	switch p.AccessType {
+	case AccessTypeEverybody:
+		return true
+	case AccessTypeAllowlist:
+		for _, allowed := range p.AllowlistAddresses() {
+			if allowed == addr {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// AllowsUnprotectedTx reports whether the unprotected tx with the given hash
+// and sender is allowlisted, by its hash or by its sender. It assumes the
+// params are valid.
+func (p Params) AllowsUnprotectedTx(hash common.Hash, sender common.Address) bool {
+	for _, allowed := range p.UnprotectedTxHashes {
+		if common.HexToHash(allowed) == hash {
+			return true
+		}
+	}
+	for _, allowed := range p.UnprotectedSenders {
+		if common.BytesToAddress(sdk.MustAccAddressFromBech32(allowed)) == sender {
+			return true
+		}
+	}
+	return false
+}
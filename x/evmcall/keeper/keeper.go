@@ -0,0 +1,114 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Asphere-xyz/tacchain/x/evmcall/types"
+)
+
+const (
+	// EventTypeCall is emitted with every contract call.
+	EventTypeCall = "evm_call"
+
+	AttributeKeySender   = "sender"
+	AttributeKeyContract = "contract"
+	AttributeKeyGasUsed  = "gas_used"
+)
+
+// Keeper of the evmcall module, which holds no state
+type Keeper struct {
+	evmKeeper  types.EVMKeeper
+	callFilter types.CallFilter
+}
+
+// NewKeeper returns a new evmcall keeper.
+func NewKeeper(evmKeeper types.EVMKeeper, callFilter types.CallFilter) Keeper {
+	return Keeper{
+		evmKeeper:  evmKeeper,
+		callFilter: callFilter,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// Call calls contract from the account of sender, charges the EVM gas used to
+// the tx and emits the EVM logs of the call. The call fails if the contract
+// reverts, and is rejected if the call filter rejects it or if its gas limit
+// exceeds the gas left in the tx.
+func (k Keeper) Call(ctx sdk.Context, sender, contract common.Address, data []byte, value *big.Int, gasLimit uint64) (*evmtypes.MsgEthereumTxResponse, error) {
+	// the EVM runs on an infinite gas meter, bounded by the gas limit only
+	if remaining := ctx.GasMeter().GasRemaining(); gasLimit > remaining {
+		return nil, errorsmod.Wrapf(types.ErrInvalidCall, "gas limit %d exceeds the %d gas left in the tx", gasLimit, remaining)
+	}
+	if err := k.callFilter.CheckCall(ctx, contract, data); err != nil {
+		return nil, err
+	}
+
+	msg := core.Message{
+		From:      sender,
+		To:        &contract,
+		Value:     value,
+		GasLimit:  gasLimit,
+		GasPrice:  new(big.Int),
+		GasFeeCap: new(big.Int),
+		GasTipCap: new(big.Int),
+		Data:      data,
+	}
+
+	// the store accesses of the EVM are charged as EVM gas
+	res, err := k.evmKeeper.ApplyMessage(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), msg, nil, true)
+	if err != nil {
+		return nil, err
+	}
+	ctx.GasMeter().ConsumeGas(res.GasUsed, "evm call")
+	if res.Failed() {
+		if res.VmError == vm.ErrExecutionReverted.Error() {
+			err = evmtypes.NewExecErrorWithReason(res.Ret)
+		} else {
+			err = errors.New(res.VmError)
+		}
+		return nil, errorsmod.Wrap(types.ErrCallFailed, err.Error())
+	}
+
+	// the logs are emitted like the ones of the Ethereum txs
+	txLogAttrs := make([]sdk.Attribute, len(res.Logs))
+	for i, log := range res.Logs {
+		value, err := json.Marshal(log)
+		if err != nil {
+			return nil, errorsmod.Wrap(err, "failed to encode log")
+		}
+		txLogAttrs[i] = sdk.NewAttribute(evmtypes.AttributeKeyTxLog, string(value))
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			EventTypeCall,
+			sdk.NewAttribute(AttributeKeySender, sender.Hex()),
+			sdk.NewAttribute(AttributeKeyContract, contract.Hex()),
+			sdk.NewAttribute(AttributeKeyGasUsed, strconv.FormatUint(res.GasUsed, 10)),
+		),
+		sdk.NewEvent(evmtypes.EventTypeTxLog, txLogAttrs...),
+	})
+	return res, nil
+}
@@ -0,0 +1,207 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/Asphere-xyz/tacchain/x/evmcall/keeper"
+	"github.com/Asphere-xyz/tacchain/x/evmcall/types"
+)
+
+var (
+	sender   = sdk.AccAddress(common.HexToAddress("0x1000000000000000000000000000000000000001").Bytes())
+	contract = common.HexToAddress("0x2000000000000000000000000000000000000002")
+	transfer = [4]byte(crypto.Keccak256([]byte("transfer(address,uint256)"))[:4])
+	approve  = [4]byte(crypto.Keccak256([]byte("approve(address,uint256)"))[:4])
+)
+
+// mockEVMKeeper records the applied messages and returns a fixed response.
+type mockEVMKeeper struct {
+	msgs []core.Message
+	res  *evmtypes.MsgEthereumTxResponse
+}
+
+var _ types.EVMKeeper = (*mockEVMKeeper)(nil)
+
+func (m *mockEVMKeeper) ApplyMessage(_ sdk.Context, msg core.Message, _ vm.EVMLogger, commit bool) (*evmtypes.MsgEthereumTxResponse, error) {
+	if !commit {
+		panic("uncommitted call")
+	}
+	m.msgs = append(m.msgs, msg)
+	return m.res, nil
+}
+
+// mockCallFilter rejects the calls of the blocked contracts.
+type mockCallFilter map[common.Address]bool
+
+var _ types.CallFilter = mockCallFilter{}
+
+func (m mockCallFilter) CheckCall(_ sdk.Context, contract common.Address, _ []byte) error {
+	if m[contract] {
+		return errortypes.ErrUnauthorized
+	}
+	return nil
+}
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper, *mockEVMKeeper) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.ModuleName)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	evmKeeper := &mockEVMKeeper{}
+	callFilter := mockCallFilter{common.HexToAddress("0x4"): true}
+	return ctx, keeper.NewKeeper(evmKeeper, callFilter), evmKeeper
+}
+
+func TestCall(t *testing.T) {
+	ctx, k, evmKeeper := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+	data := append(transfer[:], make([]byte, 64)...)
+
+	evmKeeper.res = &evmtypes.MsgEthereumTxResponse{GasUsed: 30_000, Ret: []byte{1}, Logs: []*evmtypes.Log{{Address: contract.Hex()}}}
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(100_000))
+	res, err := msgServer.Call(ctx, types.NewMsgCall(sender, contract, data, sdkmath.NewInt(5), 50_000))
+	require.NoError(t, err)
+	require.Equal(t, &types.MsgCallResponse{Ret: []byte{1}, GasUsed: 30_000}, res)
+	require.Equal(t, storetypes.Gas(30_000), ctx.GasMeter().GasConsumed())
+
+	// the EVM logs are emitted like the ones of the Ethereum txs
+	var txLogs int
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == evmtypes.EventTypeTxLog {
+			require.Len(t, event.Attributes, 1)
+			require.Contains(t, event.Attributes[0].Value, contract.Hex())
+			txLogs++
+		}
+	}
+	require.Equal(t, 1, txLogs)
+
+	// the gas limit can't exceed the gas left in the tx
+	_, err = msgServer.Call(ctx, types.NewMsgCall(sender, contract, data, sdkmath.ZeroInt(), 70_001))
+	require.ErrorIs(t, err, types.ErrInvalidCall)
+	require.Len(t, evmKeeper.msgs, 1)
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	require.Len(t, evmKeeper.msgs, 1)
+	msg := evmKeeper.msgs[0]
+	require.Equal(t, common.BytesToAddress(sender), msg.From)
+	require.Equal(t, &contract, msg.To)
+	require.Equal(t, data, msg.Data)
+	require.Equal(t, int64(5), msg.Value.Int64())
+	require.Equal(t, uint64(50_000), msg.GasLimit)
+	require.Zero(t, msg.GasPrice.Sign())
+
+	// a call without value
+	_, err = msgServer.Call(ctx, &types.MsgCall{Sender: sender.String(), Contract: contract.Hex(), Data: data, GasLimit: 50_000})
+	require.NoError(t, err)
+	require.Zero(t, evmKeeper.msgs[1].Value.Sign())
+
+	// the calls rejected by the call filter don't reach the EVM
+	_, err = msgServer.Call(ctx, types.NewMsgCall(sender, common.HexToAddress("0x4"), data, sdkmath.ZeroInt(), 50_000))
+	require.ErrorIs(t, err, errortypes.ErrUnauthorized)
+	require.Len(t, evmKeeper.msgs, 2)
+
+	// reverted calls fail with their reason
+	reason, err := abi.NewType("string", "", nil)
+	require.NoError(t, err)
+	ret, err := abi.Arguments{{Type: reason}}.Pack("not allowed")
+	require.NoError(t, err)
+	evmKeeper.res = &evmtypes.MsgEthereumTxResponse{GasUsed: 30_000, VmError: vm.ErrExecutionReverted.Error(), Ret: append(crypto.Keccak256([]byte("Error(string)"))[:4], ret...)}
+	_, err = msgServer.Call(ctx, types.NewMsgCall(sender, contract, data, sdkmath.ZeroInt(), 50_000))
+	require.ErrorIs(t, err, types.ErrCallFailed)
+	require.ErrorContains(t, err, "execution reverted: not allowed")
+
+	evmKeeper.res = &evmtypes.MsgEthereumTxResponse{GasUsed: 50_000, VmError: vm.ErrOutOfGas.Error()}
+	_, err = msgServer.Call(ctx, types.NewMsgCall(sender, contract, data, sdkmath.ZeroInt(), 50_000))
+	require.ErrorIs(t, err, types.ErrCallFailed)
+
+	// invalid calls don't reach the EVM
+	evmKeeper.msgs = nil
+	for _, msg := range []*types.MsgCall{
+		types.NewMsgCall(sender, contract, data, sdkmath.NewInt(-1), 50_000),
+		{Sender: sender.String(), Contract: "0x2", Data: data, GasLimit: 50_000},
+		types.NewMsgCall(bytes.Repeat([]byte{1}, 32), contract, data, sdkmath.ZeroInt(), 50_000),
+	} {
+		_, err = msgServer.Call(ctx, msg)
+		require.ErrorIs(t, err, types.ErrInvalidCall)
+	}
+	require.Empty(t, evmKeeper.msgs)
+}
+
+func TestCallAuthorization(t *testing.T) {
+	ctx, _, _ := setupKeeper(t)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(now)
+	data := append(transfer[:], make([]byte, 64)...)
+
+	authorization := types.NewCallAuthorization(contract, [][4]byte{transfer, approve}, sdkmath.NewInt(100), 24*time.Hour)
+	require.NoError(t, authorization.ValidateBasic())
+	require.Equal(t, "/tacchain.evmcall.v1.MsgCall", authorization.MsgTypeURL())
+
+	for _, invalid := range []*types.CallAuthorization{
+		types.NewCallAuthorization(contract, nil, sdkmath.NewInt(100), time.Hour),
+		types.NewCallAuthorization(contract, [][4]byte{transfer, transfer}, sdkmath.NewInt(100), time.Hour),
+		types.NewCallAuthorization(contract, [][4]byte{transfer}, sdkmath.NewInt(-1), time.Hour),
+		types.NewCallAuthorization(contract, [][4]byte{transfer}, sdkmath.NewInt(100), 0),
+		{Contract: "0x2", Selectors: []string{"0xa9059cbb"}, PeriodSpendLimit: sdkmath.NewInt(100), Period: time.Hour},
+		{Contract: contract.Hex(), Selectors: []string{"0xa9059c"}, PeriodSpendLimit: sdkmath.NewInt(100), Period: time.Hour},
+	} {
+		require.Error(t, invalid.ValidateBasic(), invalid)
+	}
+
+	// calls of other contracts and functions, and other messages, are rejected
+	for _, msg := range []sdk.Msg{
+		types.NewMsgCall(sender, common.HexToAddress("0x3"), data, sdkmath.ZeroInt(), 50_000),
+		types.NewMsgCall(sender, contract, crypto.Keccak256([]byte("transferFrom(address,address,uint256)"))[:4], sdkmath.ZeroInt(), 50_000),
+		types.NewMsgCall(sender, contract, transfer[:3], sdkmath.ZeroInt(), 50_000),
+		types.NewMsgCall(sender, contract, nil, sdkmath.ZeroInt(), 50_000),
+		banktypes.NewMsgSend(sender, sender, nil),
+	} {
+		_, err := authorization.Accept(ctx, msg)
+		require.Error(t, err, msg)
+	}
+
+	// the value sent is capped per period
+	res, err := authorization.Accept(ctx, types.NewMsgCall(sender, contract, data, sdkmath.NewInt(60), 50_000))
+	require.NoError(t, err)
+	require.True(t, res.Accept)
+	require.False(t, res.Delete)
+	authorization = res.Updated.(*types.CallAuthorization)
+	require.Equal(t, sdkmath.NewInt(60), authorization.PeriodSpent)
+	require.Equal(t, now.Add(24*time.Hour), authorization.PeriodReset)
+
+	_, err = authorization.Accept(ctx, types.NewMsgCall(sender, contract, data, sdkmath.NewInt(41), 50_000))
+	require.ErrorIs(t, err, errortypes.ErrInsufficientFunds)
+	res, err = authorization.Accept(ctx.WithBlockTime(now.Add(time.Hour)), types.NewMsgCall(sender, contract, approve[:], sdkmath.NewInt(40), 50_000))
+	require.NoError(t, err)
+	authorization = res.Updated.(*types.CallAuthorization)
+	require.Equal(t, sdkmath.NewInt(100), authorization.PeriodSpent)
+
+	// the next period resets the value sent
+	res, err = authorization.Accept(ctx.WithBlockTime(now.Add(25*time.Hour)), types.NewMsgCall(sender, contract, data, sdkmath.NewInt(100), 50_000))
+	require.NoError(t, err)
+	authorization = res.Updated.(*types.CallAuthorization)
+	require.Equal(t, sdkmath.NewInt(100), authorization.PeriodSpent)
+	require.Equal(t, now.Add(49*time.Hour), authorization.PeriodReset)
+}
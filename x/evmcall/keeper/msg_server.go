@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"context"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Asphere-xyz/tacchain/x/evmcall/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	k Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface for
+// the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return msgServer{k: k}
+}
+
+// Call calls a contract from the account of the sender.
+func (m msgServer) Call(ctx context.Context, msg *types.MsgCall) (*types.MsgCallResponse, error) {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+	// the accounts of the contracts and modules have no EVM address
+	if len(sender) != common.AddressLength {
+		return nil, errorsmod.Wrapf(types.ErrInvalidCall, "sender %s has no EVM address", sender)
+	}
+	contract, err := types.ParseContract(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidCall, err.Error())
+	}
+	if !msg.Value.IsNil() && msg.Value.IsNegative() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidCall, "negative value %s", msg.Value)
+	}
+	value := msg.Value.BigInt()
+	if value == nil {
+		value = new(big.Int)
+	}
+
+	res, err := m.k.Call(sdk.UnwrapSDKContext(ctx), common.BytesToAddress(sender), contract, msg.Data, value, msg.GasLimit)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgCallResponse{Ret: res.Ret, GasUsed: res.GasUsed}, nil
+}
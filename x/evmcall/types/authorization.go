@@ -0,0 +1,128 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	errortypes "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// SelectorLength is the length of the function selectors.
+const SelectorLength = 4
+
+var _ authz.Authorization = &CallAuthorization{}
+
+// NewCallAuthorization creates a new CallAuthorization instance, allowing to
+// call the functions of contract with the given selectors, and to send it up to
+// periodSpendLimit per period.
+func NewCallAuthorization(contract common.Address, selectors [][SelectorLength]byte, periodSpendLimit sdkmath.Int, period time.Duration) *CallAuthorization {
+	a := &CallAuthorization{
+		Contract:         contract.Hex(),
+		PeriodSpendLimit: periodSpendLimit,
+		Period:           period,
+		PeriodSpent:      sdkmath.ZeroInt(),
+	}
+	for _, selector := range selectors {
+		a.Selectors = append(a.Selectors, hexutil.Encode(selector[:]))
+	}
+	return a
+}
+
+// MsgTypeURL implements the authz.Authorization interface.
+func (a CallAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgCall{})
+}
+
+// Accept implements the authz.Authorization interface. It accepts the calls
+// of the functions of the contract with the allowed selectors, while the value
+// sent in the current period doesn't exceed the period spend limit.
+func (a CallAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	call, ok := msg.(*MsgCall)
+	if !ok {
+		return authz.AcceptResponse{}, errortypes.ErrInvalidType.Wrap("type mismatch")
+	}
+
+	contract, err := ParseContract(call.Contract)
+	if err != nil {
+		return authz.AcceptResponse{}, errorsmod.Wrap(ErrInvalidCall, err.Error())
+	}
+	if contract != common.HexToAddress(a.Contract) {
+		return authz.AcceptResponse{}, errortypes.ErrUnauthorized.Wrapf("cannot call contract %s", contract)
+	}
+	if !a.allowsSelector(call.Data) {
+		return authz.AcceptResponse{}, errortypes.ErrUnauthorized.Wrapf("cannot call function %x of contract %s", call.Data[:min(len(call.Data), SelectorLength)], contract)
+	}
+
+	value := call.Value
+	if value.IsNil() {
+		value = sdkmath.ZeroInt()
+	}
+	if now := sdk.UnwrapSDKContext(ctx).BlockTime(); !now.Before(a.PeriodReset) {
+		a.PeriodSpent = sdkmath.ZeroInt()
+		a.PeriodReset = now.Add(a.Period)
+	}
+	spent := a.PeriodSpent.Add(value)
+	if spent.GT(a.PeriodSpendLimit) {
+		return authz.AcceptResponse{}, errortypes.ErrInsufficientFunds.Wrapf("value %s exceeds the period spend limit %s, of which %s is spent until %s", value, a.PeriodSpendLimit, a.PeriodSpent, a.PeriodReset)
+	}
+	a.PeriodSpent = spent
+	return authz.AcceptResponse{Accept: true, Updated: &a}, nil
+}
+
+// ValidateBasic implements the authz.Authorization interface.
+func (a CallAuthorization) ValidateBasic() error {
+	if _, err := ParseContract(a.Contract); err != nil {
+		return errortypes.ErrInvalidAddress.Wrap(err.Error())
+	}
+	if len(a.Selectors) == 0 {
+		return errortypes.ErrInvalidRequest.Wrap("no function selectors")
+	}
+	seen := make(map[string]bool, len(a.Selectors))
+	for _, selector := range a.Selectors {
+		bz, err := hexutil.Decode(selector)
+		if err != nil || len(bz) != SelectorLength {
+			return errortypes.ErrInvalidRequest.Wrapf("invalid function selector %q", selector)
+		}
+		if seen[string(bz)] {
+			return errortypes.ErrInvalidRequest.Wrapf("duplicate function selector %s", selector)
+		}
+		seen[string(bz)] = true
+	}
+	if a.PeriodSpendLimit.IsNil() || a.PeriodSpendLimit.IsNegative() {
+		return errortypes.ErrInvalidRequest.Wrapf("invalid period spend limit %s", a.PeriodSpendLimit)
+	}
+	if a.Period <= 0 {
+		return errortypes.ErrInvalidRequest.Wrapf("invalid period %s", a.Period)
+	}
+	if !a.PeriodSpent.IsNil() && a.PeriodSpent.IsNegative() {
+		return errortypes.ErrInvalidRequest.Wrapf("invalid period spent %s", a.PeriodSpent)
+	}
+	return nil
+}
+
+// allowsSelector returns whether the authorization allows calling the function
+// selected by data.
+func (a CallAuthorization) allowsSelector(data []byte) bool {
+	if len(data) < SelectorLength {
+		return false
+	}
+	for _, selector := range a.Selectors {
+		if bz, err := hexutil.Decode(selector); err == nil && bytes.Equal(bz, data[:SelectorLength]) {
+			return true
+		}
+	}
+	return false
+}
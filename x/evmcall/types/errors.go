@@ -0,0 +1,13 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// x/evmcall module sentinel errors
+var (
+	ErrInvalidCall = errorsmod.Register(ModuleName, 2, "invalid evm call")
+	ErrCallFailed  = errorsmod.Register(ModuleName, 3, "evm call failed")
+)
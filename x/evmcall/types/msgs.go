@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var _ sdk.Msg = &MsgCall{}
+
+// NewMsgCall creates a new MsgCall instance.
+func NewMsgCall(sender sdk.AccAddress, contract common.Address, data []byte, value sdkmath.Int, gasLimit uint64) *MsgCall {
+	return &MsgCall{
+		Sender:   sender.String(),
+		Contract: contract.Hex(),
+		Data:     data,
+		Value:    value,
+		GasLimit: gasLimit,
+	}
+}
+
+// ParseContract parses the hex address of a contract.
+func ParseContract(contract string) (common.Address, error) {
+	if !common.IsHexAddress(contract) {
+		return common.Address{}, fmt.Errorf("invalid contract address %q", contract)
+	}
+	return common.HexToAddress(contract), nil
+}
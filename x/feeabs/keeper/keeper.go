@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Asphere-xyz/tacchain/x/feeabs/types"
+)
+
+// Keeper of the feeabs store
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	storeService  store.KVStoreService
+	accountKeeper types.AccountKeeper
+	oracleKeeper  types.OracleKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically,
+	// this should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+}
+
+// NewKeeper returns a new feeabs keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	accountKeeper types.AccountKeeper,
+	oracleKeeper types.OracleKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:           cdc,
+		storeService:  storeService,
+		accountKeeper: accountKeeper,
+		oracleKeeper:  oracleKeeper,
+		authority:     authority,
+		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// ConversionRate returns the amount of base denom a unit of denom is worth,
+// and false if the denom is not accepted for fees. The rates of the denoms
+// priced by the oracle use time-weighted average prices, so that moving the
+// price of a pair for a few blocks barely moves the rate.
+func (k Keeper) ConversionRate(ctx context.Context, denom string) (sdkmath.LegacyDec, bool, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdkmath.LegacyDec{}, false, err
+	}
+
+	fd, ok := params.FeeDenom(denom)
+	if !ok {
+		return sdkmath.LegacyDec{}, false, nil
+	}
+	if fd.OraclePair == "" {
+		return fd.Rate, true, nil
+	}
+
+	price, err := k.getPrice(ctx, fd.OraclePair, params.MaxPriceAge)
+	if err != nil {
+		return sdkmath.LegacyDec{}, false, err
+	}
+	tacPrice, err := k.getPrice(ctx, params.TacPair, params.MaxPriceAge)
+	if err != nil {
+		return sdkmath.LegacyDec{}, false, err
+	}
+
+	// the prices are the ones of whole tokens, so scale the ratio by the
+	// difference of their decimals
+	rate := price.Quo(tacPrice)
+	if fd.Exponent < types.BaseDenomExponent {
+		rate = rate.MulInt(sdkmath.NewIntWithDecimal(1, types.BaseDenomExponent-int(fd.Exponent)))
+	} else if fd.Exponent > types.BaseDenomExponent {
+		rate = rate.QuoInt(sdkmath.NewIntWithDecimal(1, int(fd.Exponent)-types.BaseDenomExponent))
+	}
+	if !rate.IsPositive() {
+		return sdkmath.LegacyDec{}, false, errorsmod.Wrapf(types.ErrInvalidPrice, "conversion rate of %s rounds to zero", denom)
+	}
+	return rate, true, nil
+}
+
+// getPrice returns the time-weighted average oracle price of a pair, which
+// must be positive. The last price of the pair must be at most maxAge blocks
+// old.
+func (k Keeper) getPrice(ctx context.Context, pair string, maxAge uint64) (sdkmath.LegacyDec, error) {
+	price, err := k.oracleKeeper.GetPrice(ctx, pair)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	if age := sdk.UnwrapSDKContext(ctx).BlockHeight() - price.Height; age < 0 || uint64(age) > maxAge {
+		return sdkmath.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidPrice, "price of %s is %d blocks old, max %d", pair, age, maxAge)
+	}
+
+	twap, err := k.oracleKeeper.GetTWAP(ctx, pair)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	if !twap.IsPositive() {
+		return sdkmath.LegacyDec{}, errorsmod.Wrapf(types.ErrInvalidPrice, "price of %s is not positive", pair)
+	}
+	return twap, nil
+}
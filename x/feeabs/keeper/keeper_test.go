@@ -0,0 +1,166 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/Asphere-xyz/tacchain/x/feeabs/keeper"
+	"github.com/Asphere-xyz/tacchain/x/feeabs/types"
+	oracletypes "github.com/Asphere-xyz/tacchain/x/oracle/types"
+)
+
+const ibcDenom = "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2"
+
+// mockOracleKeeper keeps the oracle prices in memory.
+type mockOracleKeeper map[string]oracletypes.Price
+
+var _ types.OracleKeeper = mockOracleKeeper(nil)
+
+func (m mockOracleKeeper) GetPrice(_ context.Context, pair string) (oracletypes.Price, error) {
+	price, ok := m[pair]
+	if !ok {
+		return oracletypes.Price{}, errorsmod.Wrapf(oracletypes.ErrPriceNotFound, "no price for pair %s", pair)
+	}
+	return price, nil
+}
+
+// GetTWAP returns the last price, the prices are constant over the TWAP window.
+func (m mockOracleKeeper) GetTWAP(ctx context.Context, pair string) (sdkmath.LegacyDec, error) {
+	price, err := m.GetPrice(ctx, pair)
+	return price.Price, err
+}
+
+// mockAccountKeeper keeps the module accounts in memory.
+type mockAccountKeeper map[string]sdk.ModuleAccountI
+
+var _ types.AccountKeeper = mockAccountKeeper(nil)
+
+func (m mockAccountKeeper) GetModuleAccount(_ context.Context, moduleName string) sdk.ModuleAccountI {
+	if _, ok := m[moduleName]; !ok {
+		m[moduleName] = authtypes.NewEmptyModuleAccount(moduleName)
+	}
+	return m[moduleName]
+}
+
+func setupKeeper(t *testing.T, params types.Params) (sdk.Context, keeper.Keeper, mockOracleKeeper) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx.WithBlockHeight(100)
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+
+	oracleKeeper := mockOracleKeeper{}
+	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(key), mockAccountKeeper{}, oracleKeeper, "authority")
+	require.NoError(t, k.Params.Set(ctx, params))
+	return ctx, k, oracleKeeper
+}
+
+func TestUpdateParams(t *testing.T) {
+	ctx, k, _ := setupKeeper(t, types.DefaultParams())
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	params := types.NewParams([]types.FeeDenom{
+		types.NewFixedFeeDenom("uatom", sdkmath.LegacyNewDec(1_000_000_000_000)),
+		types.NewOracleFeeDenom(ibcDenom, "USDC/USD", 6),
+	}, types.DefaultTacPair, 50)
+	_, err := msgServer.UpdateParams(ctx, types.NewMsgUpdateParams("not authority", params))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	for _, invalid := range []types.Params{
+		types.NewParams(nil, "TAC", 50),
+		types.NewParams(nil, types.DefaultTacPair, 0),
+		types.NewParams([]types.FeeDenom{types.NewFixedFeeDenom("1atom", sdkmath.LegacyOneDec())}, types.DefaultTacPair, 50),
+		types.NewParams([]types.FeeDenom{types.NewFixedFeeDenom("uatom", sdkmath.LegacyZeroDec())}, types.DefaultTacPair, 50),
+		types.NewParams([]types.FeeDenom{types.NewOracleFeeDenom("uatom", "ATOM", 6)}, types.DefaultTacPair, 50),
+		types.NewParams([]types.FeeDenom{types.NewOracleFeeDenom("uatom", "ATOM/USD", 37)}, types.DefaultTacPair, 50),
+		types.NewParams([]types.FeeDenom{{Denom: "uatom", Rate: sdkmath.LegacyOneDec(), OraclePair: "ATOM/USD"}}, types.DefaultTacPair, 50),
+		types.NewParams([]types.FeeDenom{
+			types.NewFixedFeeDenom("uatom", sdkmath.LegacyOneDec()),
+			types.NewOracleFeeDenom("uatom", "ATOM/USD", 6),
+		}, types.DefaultTacPair, 50),
+	} {
+		_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams("authority", invalid))
+		require.ErrorIs(t, err, types.ErrInvalidParams, invalid)
+	}
+
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams("authority", params))
+	require.NoError(t, err)
+
+	res, err := keeper.NewQueryServerImpl(k).Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, res.Params)
+}
+
+func TestConversionRate(t *testing.T) {
+	ctx, k, oracleKeeper := setupKeeper(t, types.NewParams([]types.FeeDenom{
+		types.NewFixedFeeDenom("uatom", sdkmath.LegacyNewDec(1_000_000_000_000)),
+		types.NewOracleFeeDenom(ibcDenom, "USDC/USD", 6),
+	}, types.DefaultTacPair, 10))
+	queryServer := keeper.NewQueryServerImpl(k)
+
+	rate, ok, err := k.ConversionRate(ctx, "uatom")
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, sdkmath.LegacyNewDec(1_000_000_000_000), rate)
+
+	_, ok, err = k.ConversionRate(ctx, "uosmo")
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// no price yet
+	_, _, err = k.ConversionRate(ctx, ibcDenom)
+	require.ErrorIs(t, err, oracletypes.ErrPriceNotFound)
+
+	// 1 USDC = 4 TAC, so 1e-6 USDC = 4e-6 TAC = 4e12 utac
+	oracleKeeper["USDC/USD"] = oracletypes.Price{Pair: "USDC/USD", Price: sdkmath.LegacyOneDec(), Height: 95}
+	oracleKeeper[types.DefaultTacPair] = oracletypes.Price{Pair: types.DefaultTacPair, Price: sdkmath.LegacyNewDecWithPrec(25, 2), Height: 90}
+	rate, ok, err = k.ConversionRate(ctx, ibcDenom)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, sdkmath.LegacyNewDec(4_000_000_000_000), rate)
+
+	res, err := queryServer.Rate(ctx, &types.QueryRateRequest{Denom: ibcDenom})
+	require.NoError(t, err)
+	require.Equal(t, rate, res.Rate)
+
+	// stale price
+	oracleKeeper[types.DefaultTacPair] = oracletypes.Price{Pair: types.DefaultTacPair, Price: sdkmath.LegacyNewDecWithPrec(25, 2), Height: 89}
+	_, _, err = k.ConversionRate(ctx, ibcDenom)
+	require.ErrorIs(t, err, types.ErrInvalidPrice)
+
+	_, err = queryServer.Rate(ctx, &types.QueryRateRequest{Denom: "uosmo"})
+	require.Error(t, err)
+	_, err = queryServer.Rate(ctx, &types.QueryRateRequest{})
+	require.Error(t, err)
+}
+
+func TestInitGenesis(t *testing.T) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+
+	accountKeeper := mockAccountKeeper{}
+	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(key), accountKeeper, mockOracleKeeper{}, "authority")
+	require.NoError(t, k.InitGenesis(ctx, *types.DefaultGenesisState()))
+	require.Contains(t, accountKeeper, types.ModuleName)
+
+	gs, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultGenesisState(), gs)
+}
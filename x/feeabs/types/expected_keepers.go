@@ -0,0 +1,28 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import (
+	"context"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	oracletypes "github.com/Asphere-xyz/tacchain/x/oracle/types"
+)
+
+// OracleKeeper defines the oracle methods used to compute the conversion
+// rates of the fee denoms.
+type OracleKeeper interface {
+	GetPrice(ctx context.Context, pair string) (oracletypes.Price, error)
+	GetTWAP(ctx context.Context, pair string) (sdkmath.LegacyDec, error)
+}
+
+// AccountKeeper defines the account methods used to create the module account
+// holding the reserve paying the converted fees.
+type AccountKeeper interface {
+	GetModuleAccount(ctx context.Context, moduleName string) sdk.ModuleAccountI
+}
@@ -0,0 +1,121 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	oracletypes "github.com/Asphere-xyz/tacchain/x/oracle/types"
+)
+
+// BaseDenomExponent is the number of decimals of the base denom, which is the
+// EVM denom and has 18 decimals like ether.
+const BaseDenomExponent = 18
+
+// maxExponent is the maximum number of decimals of a fee denom.
+const maxExponent = 36
+
+// DefaultTacPair is the default oracle pair giving the price of TAC.
+const DefaultTacPair = "TAC/USD"
+
+// DefaultMaxPriceAge is the default maximum age, in blocks, of the oracle
+// prices used to compute conversion rates.
+const DefaultMaxPriceAge uint64 = 100
+
+// NewParams creates a new Params instance.
+func NewParams(feeDenoms []FeeDenom, tacPair string, maxPriceAge uint64) Params {
+	return Params{
+		FeeDenoms:   feeDenoms,
+		TacPair:     tacPair,
+		MaxPriceAge: maxPriceAge,
+	}
+}
+
+// DefaultParams returns the default feeabs parameters. No denom other than the
+// base denom is accepted until governance adds one.
+func DefaultParams() Params {
+	return NewParams(nil, DefaultTacPair, DefaultMaxPriceAge)
+}
+
+// Validate performs a basic validation of the feeabs parameters.
+func (p Params) Validate() error {
+	if err := oracletypes.ValidatePair(p.TacPair); err != nil {
+		return fmt.Errorf("invalid tac pair: %w", err)
+	}
+	if p.MaxPriceAge == 0 {
+		return fmt.Errorf("max price age must be positive")
+	}
+
+	seen := make(map[string]struct{}, len(p.FeeDenoms))
+	for _, fd := range p.FeeDenoms {
+		if err := fd.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seen[fd.Denom]; ok {
+			return fmt.Errorf("duplicate fee denom %s", fd.Denom)
+		}
+		seen[fd.Denom] = struct{}{}
+	}
+	return nil
+}
+
+// FeeDenom returns the fee denom of the given denom, if accepted.
+func (p Params) FeeDenom(denom string) (FeeDenom, bool) {
+	for _, fd := range p.FeeDenoms {
+		if fd.Denom == denom {
+			return fd, true
+		}
+	}
+	return FeeDenom{}, false
+}
+
+// NewFixedFeeDenom creates a fee denom with a fixed conversion rate.
+func NewFixedFeeDenom(denom string, rate sdkmath.LegacyDec) FeeDenom {
+	return FeeDenom{
+		Denom: denom,
+		Rate:  rate,
+	}
+}
+
+// NewOracleFeeDenom creates a fee denom whose conversion rate follows the
+// oracle price of a pair.
+func NewOracleFeeDenom(denom, oraclePair string, exponent uint32) FeeDenom {
+	return FeeDenom{
+		Denom:      denom,
+		Rate:       sdkmath.LegacyZeroDec(),
+		OraclePair: oraclePair,
+		Exponent:   exponent,
+	}
+}
+
+// Validate performs a basic validation of a fee denom. It must either have a
+// positive fixed rate or an oracle pair, not both.
+func (fd FeeDenom) Validate() error {
+	if err := sdk.ValidateDenom(fd.Denom); err != nil {
+		return err
+	}
+
+	if fd.OraclePair == "" {
+		if fd.Rate.IsNil() || !fd.Rate.IsPositive() {
+			return fmt.Errorf("rate of fee denom %s must be positive, got %s", fd.Denom, fd.Rate)
+		}
+		return nil
+	}
+
+	if err := oracletypes.ValidatePair(fd.OraclePair); err != nil {
+		return fmt.Errorf("invalid oracle pair of fee denom %s: %w", fd.Denom, err)
+	}
+	if !fd.Rate.IsNil() && !fd.Rate.IsZero() {
+		return fmt.Errorf("fee denom %s cannot have both a rate and an oracle pair", fd.Denom)
+	}
+	if fd.Exponent > maxExponent {
+		return fmt.Errorf("exponent of fee denom %s must be at most %d, got %d", fd.Denom, maxExponent, fd.Exponent)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/Asphere-xyz/tacchain/x/feeburn/types"
+)
+
+const (
+	// EventTypeFeeBurn is emitted with the split of the fees of every tx.
+	EventTypeFeeBurn = "fee_burn"
+
+	AttributeKeyBurned        = "burned"
+	AttributeKeyCommunityPool = "community_pool"
+	AttributeKeyStakers       = "stakers"
+)
+
+// SplitFees splits the fees of a tx held by the fee collector: the burn rate
+// of the base fee is burned, and the rest of the fees is shared between the
+// community pool and the stakers, whose share is left in the fee collector to
+// be distributed by the distribution module.
+func (k Keeper) SplitFees(ctx sdk.Context, baseFee, tips sdk.Coins) error {
+	if baseFee.IsZero() && tips.IsZero() {
+		return nil
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	burned := mulCoins(baseFee, params.BaseFeeBurnRate)
+	rest := baseFee.Sub(burned...).Add(tips...)
+	communityPool := mulCoins(rest, params.CommunityPoolShare)
+	stakers := rest.Sub(communityPool...)
+
+	if !burned.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, burned); err != nil {
+			return err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burned); err != nil {
+			return err
+		}
+		if err := k.addBurned(ctx, burned); err != nil {
+			return err
+		}
+	}
+	if !communityPool.IsZero() {
+		feeCollector := k.accountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+		if err := k.distrKeeper.FundCommunityPool(ctx, communityPool, feeCollector); err != nil {
+			return err
+		}
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		EventTypeFeeBurn,
+		sdk.NewAttribute(AttributeKeyBurned, burned.String()),
+		sdk.NewAttribute(AttributeKeyCommunityPool, communityPool.String()),
+		sdk.NewAttribute(AttributeKeyStakers, stakers.String()),
+	))
+	return nil
+}
+
+// mulCoins returns the share of coins, truncated.
+func mulCoins(coins sdk.Coins, share sdkmath.LegacyDec) sdk.Coins {
+	res := sdk.NewCoins()
+	for _, coin := range coins {
+		res = res.Add(sdk.NewCoin(coin.Denom, share.MulInt(coin.Amount).TruncateInt()))
+	}
+	return res
+}
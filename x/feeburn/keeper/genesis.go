@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Asphere-xyz/tacchain/x/feeburn/types"
+)
+
+// InitGenesis initializes the feeburn module's state from a given genesis
+// state, and creates the module account burning the fees.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) error {
+	k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+	for _, coin := range gs.Burned {
+		if err := k.Burned.Set(ctx, coin.Denom, coin.Amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportGenesis returns the feeburn module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+	burned, err := k.GetBurned(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewGenesisState(params, burned), nil
+}
@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Asphere-xyz/tacchain/x/feeburn/types"
+)
+
+// Keeper of the feeburn store
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	storeService  store.KVStoreService
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	distrKeeper   types.DistributionKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically,
+	// this should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// Burned holds the cumulative amounts burned by denom
+	Burned collections.Map[string, sdkmath.Int]
+}
+
+// NewKeeper returns a new feeburn keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	distrKeeper types.DistributionKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:           cdc,
+		storeService:  storeService,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		distrKeeper:   distrKeeper,
+		authority:     authority,
+		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Burned:        collections.NewMap(sb, types.BurnedKey, "burned", collections.StringKey, sdk.IntValue),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetBurned returns the cumulative amount of fees burned.
+func (k Keeper) GetBurned(ctx context.Context) (sdk.Coins, error) {
+	var burned sdk.Coins
+	err := k.Burned.Walk(ctx, nil, func(denom string, amount sdkmath.Int) (bool, error) {
+		burned = append(burned, sdk.NewCoin(denom, amount))
+		return false, nil
+	})
+	return burned, err
+}
+
+// addBurned adds coins to the cumulative amount of fees burned.
+func (k Keeper) addBurned(ctx context.Context, coins sdk.Coins) error {
+	for _, coin := range coins {
+		burned, err := k.Burned.Get(ctx, coin.Denom)
+		if errors.Is(err, collections.ErrNotFound) {
+			burned = sdkmath.ZeroInt()
+		} else if err != nil {
+			return err
+		}
+		if err := k.Burned.Set(ctx, coin.Denom, burned.Add(coin.Amount)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
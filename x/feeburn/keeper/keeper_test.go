@@ -0,0 +1,171 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/Asphere-xyz/tacchain/x/feeburn/keeper"
+	"github.com/Asphere-xyz/tacchain/x/feeburn/types"
+)
+
+// mockAccountKeeper keeps the module accounts in memory.
+type mockAccountKeeper map[string]sdk.ModuleAccountI
+
+var _ types.AccountKeeper = mockAccountKeeper(nil)
+
+func (m mockAccountKeeper) GetModuleAccount(_ context.Context, moduleName string) sdk.ModuleAccountI {
+	if _, ok := m[moduleName]; !ok {
+		m[moduleName] = authtypes.NewEmptyModuleAccount(moduleName)
+	}
+	return m[moduleName]
+}
+
+func (mockAccountKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
+	return authtypes.NewModuleAddress(moduleName)
+}
+
+// mockBankKeeper keeps the balances of the module accounts in memory.
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+	burned   sdk.Coins
+}
+
+var _ types.BankKeeper = (*mockBankKeeper)(nil)
+
+func (m *mockBankKeeper) SendCoinsFromModuleToModule(_ context.Context, sender, recipient string, amt sdk.Coins) error {
+	m.balances[sender] = m.balances[sender].Sub(amt...)
+	m.balances[recipient] = m.balances[recipient].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) BurnCoins(_ context.Context, moduleName string, amt sdk.Coins) error {
+	m.balances[moduleName] = m.balances[moduleName].Sub(amt...)
+	m.burned = m.burned.Add(amt...)
+	return nil
+}
+
+// mockDistrKeeper records the funds of the community pool.
+type mockDistrKeeper struct {
+	bankKeeper    *mockBankKeeper
+	communityPool sdk.Coins
+}
+
+var _ types.DistributionKeeper = (*mockDistrKeeper)(nil)
+
+func (m *mockDistrKeeper) FundCommunityPool(_ context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	if !sender.Equals(authtypes.NewModuleAddress(authtypes.FeeCollectorName)) {
+		panic("unexpected sender")
+	}
+	m.bankKeeper.balances[authtypes.FeeCollectorName] = m.bankKeeper.balances[authtypes.FeeCollectorName].Sub(amount...)
+	m.communityPool = m.communityPool.Add(amount...)
+	return nil
+}
+
+func setupKeeper(t *testing.T, params types.Params) (sdk.Context, keeper.Keeper, *mockBankKeeper, *mockDistrKeeper) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+
+	bankKeeper := &mockBankKeeper{balances: map[string]sdk.Coins{}}
+	distrKeeper := &mockDistrKeeper{bankKeeper: bankKeeper}
+	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(key), mockAccountKeeper{}, bankKeeper, distrKeeper, "authority")
+	require.NoError(t, k.Params.Set(ctx, params))
+	return ctx, k, bankKeeper, distrKeeper
+}
+
+func TestParamsValidate(t *testing.T) {
+	for _, tc := range []struct {
+		name   string
+		params types.Params
+		ok     bool
+	}{
+		{"default", types.DefaultParams(), true},
+		{"no burn", types.NewParams(sdkmath.LegacyZeroDec(), sdkmath.LegacyOneDec(), sdkmath.LegacyZeroDec()), true},
+		{"empty", types.Params{}, false},
+		{"burn above 1", types.NewParams(sdkmath.LegacyNewDec(2), sdkmath.LegacyZeroDec(), sdkmath.LegacyOneDec()), false},
+		{"negative burn", types.NewParams(sdkmath.LegacyNewDec(-1), sdkmath.LegacyZeroDec(), sdkmath.LegacyOneDec()), false},
+		{"negative share", types.NewParams(sdkmath.LegacyOneDec(), sdkmath.LegacyNewDec(-1), sdkmath.LegacyNewDec(2)), false},
+		{"shares below 1", types.NewParams(sdkmath.LegacyOneDec(), sdkmath.LegacyNewDecWithPrec(5, 1), sdkmath.LegacyNewDecWithPrec(4, 1)), false},
+		{"shares above 1", types.NewParams(sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec(), sdkmath.LegacyOneDec()), false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.ok {
+				require.NoError(t, tc.params.Validate())
+			} else {
+				require.Error(t, tc.params.Validate())
+			}
+		})
+	}
+}
+
+func TestSplitFees(t *testing.T) {
+	coins := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("utac", amount)) }
+
+	testCases := []struct {
+		name          string
+		params        types.Params
+		burned        sdk.Coins
+		communityPool sdk.Coins
+		stakers       sdk.Coins
+	}{
+		{
+			name:    "default params",
+			params:  types.DefaultParams(),
+			burned:  coins(1000),
+			stakers: coins(101),
+		},
+		{
+			name:          "shares",
+			params:        types.NewParams(sdkmath.LegacyNewDecWithPrec(5, 1), sdkmath.LegacyNewDecWithPrec(2, 1), sdkmath.LegacyNewDecWithPrec(8, 1)),
+			burned:        coins(500),
+			communityPool: coins(120),
+			stakers:       coins(481),
+		},
+		{
+			name:          "no burn",
+			params:        types.NewParams(sdkmath.LegacyZeroDec(), sdkmath.LegacyOneDec(), sdkmath.LegacyZeroDec()),
+			communityPool: coins(1101),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, k, bankKeeper, distrKeeper := setupKeeper(t, tc.params)
+			bankKeeper.balances[authtypes.FeeCollectorName] = coins(3000)
+
+			require.NoError(t, k.SplitFees(ctx, coins(1000), coins(101)))
+			require.Equal(t, tc.burned, bankKeeper.burned)
+			require.Equal(t, tc.communityPool, distrKeeper.communityPool)
+			// the share of the stakers is left in the fee collector
+			require.Equal(t, coins(1899).Add(tc.stakers...), bankKeeper.balances[authtypes.FeeCollectorName])
+
+			// the burned fees are counted
+			require.NoError(t, k.SplitFees(ctx, coins(1000), nil))
+			res, err := keeper.NewQueryServerImpl(k).Burned(ctx, &types.QueryBurnedRequest{})
+			require.NoError(t, err)
+			require.Equal(t, bankKeeper.burned, res.Burned)
+
+			gs, err := k.ExportGenesis(ctx)
+			require.NoError(t, err)
+			require.Equal(t, bankKeeper.burned, gs.Burned)
+			require.NoError(t, gs.Validate())
+		})
+	}
+}
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, burned sdk.Coins) *GenesisState {
+	return &GenesisState{
+		Params: params,
+		Burned: burned,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the feeburn
+// module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil)
+}
+
+// Validate performs a basic validation of the genesis state.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if err := gs.Burned.Validate(); err != nil {
+		return fmt.Errorf("invalid burned coins: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// NewParams creates a new Params instance.
+func NewParams(baseFeeBurnRate, communityPoolShare, stakersShare sdkmath.LegacyDec) Params {
+	return Params{
+		BaseFeeBurnRate:    baseFeeBurnRate,
+		CommunityPoolShare: communityPoolShare,
+		StakersShare:       stakersShare,
+	}
+}
+
+// DefaultParams returns the default feeburn parameters, which burn the whole
+// base fee and leave the rest of the fees to the stakers.
+func DefaultParams() Params {
+	return NewParams(sdkmath.LegacyOneDec(), sdkmath.LegacyZeroDec(), sdkmath.LegacyOneDec())
+}
+
+// Validate performs a basic validation of the feeburn parameters. The rates
+// must be between 0 and 1, and the shares of the fees not burned must add up
+// to 1.
+func (p Params) Validate() error {
+	for _, rate := range []struct {
+		name  string
+		value sdkmath.LegacyDec
+	}{
+		{"base fee burn rate", p.BaseFeeBurnRate},
+		{"community pool share", p.CommunityPoolShare},
+		{"stakers share", p.StakersShare},
+	} {
+		if rate.value.IsNil() || rate.value.IsNegative() || rate.value.GT(sdkmath.LegacyOneDec()) {
+			return fmt.Errorf("%s must be between 0 and 1: %s", rate.name, rate.value)
+		}
+	}
+	if !p.CommunityPoolShare.Add(p.StakersShare).Equal(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("community pool share %s and stakers share %s must add up to 1", p.CommunityPoolShare, p.StakersShare)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Asphere-xyz/tacchain/x/msgfilter/types"
+)
+
+// MsgFees returns the minimum fee due for msgs, the sum of the minimum fees of
+// their message types, and the highest gas multiplier of the types, which is 1
+// if none is set. The messages nested by authz MsgExec and group
+// MsgSubmitProposal are included, so that they cannot be used to avoid the
+// fees.
+func (k Keeper) MsgFees(ctx context.Context, msgs []sdk.Msg) (sdk.Coins, sdkmath.LegacyDec, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, sdkmath.LegacyDec{}, err
+	}
+
+	minFee, multiplier := sdk.NewCoins(), sdkmath.LegacyOneDec()
+	if len(params.MsgFees) == 0 {
+		return minFee, multiplier, nil
+	}
+	if err := addMsgFees(params, msgs, 0, &minFee, &multiplier); err != nil {
+		return nil, sdkmath.LegacyDec{}, err
+	}
+	return minFee, multiplier, nil
+}
+
+func addMsgFees(params types.Params, msgs []sdk.Msg, depth int, minFee *sdk.Coins, multiplier *sdkmath.LegacyDec) error {
+	for _, msg := range msgs {
+		if msgFee, ok := params.MsgFee(sdk.MsgTypeURL(msg)); ok {
+			*minFee = minFee.Add(msgFee.MinFee...)
+			*multiplier = sdkmath.LegacyMaxDec(*multiplier, msgFee.Multiplier())
+		}
+
+		nested, err := NestedMsgs(msg)
+		if err != nil {
+			return err
+		}
+		if len(nested) == 0 {
+			continue
+		}
+		if depth >= MaxNestedMsgDepth {
+			return errorsmod.Wrapf(types.ErrNestedMsgs, "more than %d levels", MaxNestedMsgDepth)
+		}
+		if err := addMsgFees(params, nested, depth+1, minFee, multiplier); err != nil {
+			return err
+		}
+	}
+	return nil
+}
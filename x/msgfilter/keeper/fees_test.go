@@ -0,0 +1,57 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	"github.com/Asphere-xyz/tacchain/x/msgfilter/types"
+)
+
+func TestMsgFees(t *testing.T) {
+	storeFee := sdk.NewCoins(sdk.NewInt64Coin("utac", 1000))
+	sendFee := sdk.NewCoins(sdk.NewInt64Coin("utac", 10), sdk.NewInt64Coin("uatom", 1))
+	ctx, k := setupKeeper(t, types.NewParams(nil, []types.MsgFee{
+		types.NewMsgFee(sdk.MsgTypeURL(&wasmtypes.MsgStoreCode{}), storeFee, sdkmath.LegacyNewDec(3)),
+		types.NewMsgFee(sdk.MsgTypeURL(&banktypes.MsgSend{}), sendFee, sdkmath.LegacyZeroDec()),
+		types.NewMsgFee(sdk.MsgTypeURL(&banktypes.MsgMultiSend{}), nil, sdkmath.LegacyNewDecWithPrec(15, 1)),
+	}))
+
+	store := &wasmtypes.MsgStoreCode{}
+	send := &banktypes.MsgSend{}
+	multiSend := &banktypes.MsgMultiSend{}
+
+	testCases := []struct {
+		name       string
+		msgs       []sdk.Msg
+		minFee     sdk.Coins
+		multiplier sdkmath.LegacyDec
+	}{
+		{"no fees", []sdk.Msg{&banktypes.MsgUpdateParams{}}, sdk.NewCoins(), sdkmath.LegacyOneDec()},
+		{"minimum fee", []sdk.Msg{send}, sendFee, sdkmath.LegacyOneDec()},
+		{"gas multiplier", []sdk.Msg{multiSend}, sdk.NewCoins(), sdkmath.LegacyNewDecWithPrec(15, 1)},
+		{"fee per msg", []sdk.Msg{send, send}, sendFee.Add(sendFee...), sdkmath.LegacyOneDec()},
+		{"highest multiplier", []sdk.Msg{multiSend, store}, storeFee, sdkmath.LegacyNewDec(3)},
+		{"nested msgs", []sdk.Msg{newMsgExec(send, newGroupProposal(t, store))}, storeFee.Add(sendFee...), sdkmath.LegacyNewDec(3)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			minFee, multiplier, err := k.MsgFees(ctx, tc.msgs)
+			require.NoError(t, err)
+			require.Equal(t, tc.minFee, minFee)
+			require.Equal(t, tc.multiplier, multiplier)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/cosmos/cosmos-sdk/x/group"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/Asphere-xyz/tacchain/x/msgfilter/types"
+)
+
+// MaxNestedMsgDepth bounds the levels of nested messages that are checked. A tx
+// nesting messages any deeper is rejected.
+const MaxNestedMsgDepth = 6
+
+// CheckMsgs returns ErrDisabledMsg if any of msgs, or of the messages nested in
+// them by authz MsgExec and group MsgSubmitProposal, is disabled. MsgEthereumTx
+// is always rejected when nested, since it's only valid at the top level of an
+// Ethereum tx.
+//
+// Group proposals are checked when submitted, and again when executed through
+// the router of NewMessageRouter. Messages of gov proposals are not checked at
+// all, since governance controls the list.
+func (k Keeper) CheckMsgs(ctx context.Context, msgs []sdk.Msg) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	return checkMsgs(params, msgs, 0)
+}
+
+func checkMsgs(params types.Params, msgs []sdk.Msg, depth int) error {
+	for _, msg := range msgs {
+		typeURL := sdk.MsgTypeURL(msg)
+		if params.IsDisabled(typeURL) {
+			return errorsmod.Wrap(types.ErrDisabledMsg, typeURL)
+		}
+		if _, ok := msg.(*evmtypes.MsgEthereumTx); ok && depth > 0 {
+			return errorsmod.Wrapf(types.ErrDisabledMsg, "%s cannot be nested", typeURL)
+		}
+
+		nested, err := NestedMsgs(msg)
+		if err != nil {
+			return err
+		}
+		if len(nested) == 0 {
+			continue
+		}
+		if depth >= MaxNestedMsgDepth {
+			return errorsmod.Wrapf(types.ErrNestedMsgs, "more than %d levels", MaxNestedMsgDepth)
+		}
+		if err := checkMsgs(params, nested, depth+1); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// NestedMsgs returns the messages executed on behalf of msg.
+func NestedMsgs(msg sdk.Msg) ([]sdk.Msg, error) {
+	switch msg := msg.(type) {
+	case *authz.MsgExec:
+		return msg.GetMessages()
+	case *group.MsgSubmitProposal:
+		return msg.GetMsgs()
+	default:
+		return nil, nil
+	}
+}
+
+// MessageRouter defines the router of the messages executed by a module on
+// behalf of its accounts, such as the interchain accounts host or x/group.
+type MessageRouter interface {
+	Handler(msg sdk.Msg) baseapp.MsgServiceHandler
+	HandlerByTypeURL(typeURL string) baseapp.MsgServiceHandler
+}
+
+type msgRouter struct {
+	k      Keeper
+	router MessageRouter
+}
+
+// NewMessageRouter wraps router to reject disabled messages, along with the
+// disabled messages nested in them, before they are executed.
+func NewMessageRouter(k Keeper, router MessageRouter) MessageRouter {
+	return msgRouter{k: k, router: router}
+}
+
+// Handler returns the handler of msg, or nil if msg has no route.
+func (r msgRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	return r.filter(r.router.Handler(msg))
+}
+
+// HandlerByTypeURL returns the handler of the messages with the given type
+// URL, or nil if they have no route.
+func (r msgRouter) HandlerByTypeURL(typeURL string) baseapp.MsgServiceHandler {
+	return r.filter(r.router.HandlerByTypeURL(typeURL))
+}
+
+// filter wraps handler to reject disabled messages.
+func (r msgRouter) filter(handler baseapp.MsgServiceHandler) baseapp.MsgServiceHandler {
+	if handler == nil {
+		return nil
+	}
+
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		if err := r.k.CheckMsgs(ctx, []sdk.Msg{msg}); err != nil {
+			return nil, err
+		}
+		return handler(ctx, msg)
+	}
+}
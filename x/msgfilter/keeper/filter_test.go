@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	"github.com/Asphere-xyz/tacchain/x/msgfilter/keeper"
+	"github.com/Asphere-xyz/tacchain/x/msgfilter/types"
+)
+
+func newMsgExec(msgs ...sdk.Msg) sdk.Msg {
+	msg := authz.NewMsgExec(sdk.AccAddress("grantee"), msgs)
+	return &msg
+}
+
+func newGroupProposal(t *testing.T, msgs ...sdk.Msg) sdk.Msg {
+	t.Helper()
+
+	msg := &group.MsgSubmitProposal{}
+	require.NoError(t, msg.SetMsgs(msgs))
+	return msg
+}
+
+func TestCheckMsgs(t *testing.T) {
+	ctx, k := setupKeeper(t, types.DefaultParams())
+
+	send := &banktypes.MsgSend{}
+	vesting := &vestingtypes.MsgCreateVestingAccount{}
+
+	var nested sdk.Msg = send
+	for i := 0; i < 7; i++ {
+		nested = newMsgExec(nested)
+	}
+
+	testCases := []struct {
+		name string
+		msgs []sdk.Msg
+		err  error
+	}{
+		{
+			name: "allowed msgs",
+			msgs: []sdk.Msg{send, newMsgExec(send), newGroupProposal(t, send), &evmtypes.MsgEthereumTx{}},
+		},
+		{
+			name: "disabled msg",
+			msgs: []sdk.Msg{send, vesting},
+			err:  types.ErrDisabledMsg,
+		},
+		{
+			name: "disabled msg in authz exec",
+			msgs: []sdk.Msg{newMsgExec(send, vesting)},
+			err:  types.ErrDisabledMsg,
+		},
+		{
+			name: "disabled msg in group proposal",
+			msgs: []sdk.Msg{newGroupProposal(t, newMsgExec(vesting))},
+			err:  types.ErrDisabledMsg,
+		},
+		{
+			name: "nested ethereum tx",
+			msgs: []sdk.Msg{newMsgExec(&evmtypes.MsgEthereumTx{})},
+			err:  types.ErrDisabledMsg,
+		},
+		{
+			name: "too many nested msgs",
+			msgs: []sdk.Msg{nested},
+			err:  types.ErrNestedMsgs,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := k.CheckMsgs(ctx, tc.msgs)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+// testRouter routes bank sends only.
+type testRouter struct{}
+
+func (testRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	if _, ok := msg.(*banktypes.MsgSend); !ok {
+		return nil
+	}
+	return func(sdk.Context, sdk.Msg) (*sdk.Result, error) { return &sdk.Result{}, nil }
+}
+
+func (r testRouter) HandlerByTypeURL(typeURL string) baseapp.MsgServiceHandler {
+	if typeURL != sdk.MsgTypeURL(&banktypes.MsgSend{}) {
+		return nil
+	}
+	return r.Handler(&banktypes.MsgSend{})
+}
+
+func TestMessageRouter(t *testing.T) {
+	ctx, k := setupKeeper(t, types.NewParams([]string{sdk.MsgTypeURL(&banktypes.MsgSend{})}, nil))
+	router := keeper.NewMessageRouter(k, testRouter{})
+
+	require.Nil(t, router.Handler(&banktypes.MsgMultiSend{}))
+
+	handler := router.Handler(&banktypes.MsgSend{})
+	require.NotNil(t, handler)
+	_, err := handler(ctx, &banktypes.MsgSend{})
+	require.ErrorIs(t, err, types.ErrDisabledMsg)
+
+	handler = router.HandlerByTypeURL(sdk.MsgTypeURL(&banktypes.MsgSend{}))
+	require.NotNil(t, handler)
+	_, err = handler(ctx, &banktypes.MsgSend{})
+	require.ErrorIs(t, err, types.ErrDisabledMsg)
+
+	require.NoError(t, k.Params.Set(ctx, types.DefaultParams()))
+	_, err = handler(ctx, &banktypes.MsgSend{})
+	require.NoError(t, err)
+}
@@ -0,0 +1,92 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/Asphere-xyz/tacchain/x/msgfilter/keeper"
+	"github.com/Asphere-xyz/tacchain/x/msgfilter/types"
+)
+
+func setupKeeper(t *testing.T, params types.Params) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+
+	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(key), "authority")
+	require.NoError(t, k.Params.Set(ctx, params))
+	return ctx, k
+}
+
+func TestUpdateParams(t *testing.T) {
+	ctx, k := setupKeeper(t, types.DefaultParams())
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	params := types.NewParams([]string{"/cosmos.bank.v1beta1.MsgMultiSend"}, nil)
+	_, err := msgServer.UpdateParams(ctx, types.NewMsgUpdateParams("not authority", params))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	for _, invalid := range [][]string{
+		{"cosmos.bank.v1beta1.MsgMultiSend"},
+		{"/cosmos.bank.v1beta1.MsgMultiSend", "/cosmos.bank.v1beta1.MsgMultiSend"},
+		{"/ethermint.evm.v1.MsgEthereumTx"},
+		{"/tacchain.msgfilter.v1.MsgUpdateParams"},
+	} {
+		_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams("authority", types.NewParams(invalid, nil)))
+		require.ErrorIs(t, err, types.ErrInvalidParams, invalid)
+	}
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin("utac", 1000))
+	for _, invalid := range [][]types.MsgFee{
+		{types.NewMsgFee("cosmos.bank.v1beta1.MsgSend", fee, sdkmath.LegacyZeroDec())},
+		{types.NewMsgFee("/cosmos.bank.v1beta1.MsgSend", nil, sdkmath.LegacyZeroDec())},
+		{types.NewMsgFee("/cosmos.bank.v1beta1.MsgSend", sdk.Coins{sdk.Coin{Denom: "utac", Amount: sdkmath.NewInt(-1)}}, sdkmath.LegacyZeroDec())},
+		{types.NewMsgFee("/cosmos.bank.v1beta1.MsgSend", fee, sdkmath.LegacyNewDecWithPrec(5, 1))},
+		{types.NewMsgFee("/cosmos.bank.v1beta1.MsgSend", fee, sdkmath.LegacyNewDec(101))},
+		{
+			types.NewMsgFee("/cosmos.bank.v1beta1.MsgSend", fee, sdkmath.LegacyZeroDec()),
+			types.NewMsgFee("/cosmos.bank.v1beta1.MsgSend", nil, sdkmath.LegacyNewDec(2)),
+		},
+	} {
+		_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams("authority", types.NewParams(nil, invalid)))
+		require.ErrorIs(t, err, types.ErrInvalidParams, invalid)
+	}
+
+	_, err = msgServer.UpdateParams(ctx, types.NewMsgUpdateParams("authority", params))
+	require.NoError(t, err)
+
+	res, err := keeper.NewQueryServerImpl(k).Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, res.Params)
+}
+
+func TestQueryMsgDisabled(t *testing.T) {
+	ctx, k := setupKeeper(t, types.DefaultParams())
+	queryServer := keeper.NewQueryServerImpl(k)
+
+	res, err := queryServer.MsgDisabled(ctx, &types.QueryMsgDisabledRequest{TypeUrl: "/cosmos.vesting.v1beta1.MsgCreateVestingAccount"})
+	require.NoError(t, err)
+	require.True(t, res.Disabled)
+
+	res, err = queryServer.MsgDisabled(ctx, &types.QueryMsgDisabledRequest{TypeUrl: "/cosmos.bank.v1beta1.MsgSend"})
+	require.NoError(t, err)
+	require.False(t, res.Disabled)
+
+	_, err = queryServer.MsgDisabled(ctx, &types.QueryMsgDisabledRequest{})
+	require.Error(t, err)
+}
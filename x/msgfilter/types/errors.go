@@ -0,0 +1,15 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// x/msgfilter module sentinel errors
+var (
+	ErrInvalidSigner = errorsmod.Register(ModuleName, 2, "expected gov account as only signer for proposal message")
+	ErrInvalidParams = errorsmod.Register(ModuleName, 3, "invalid msgfilter params")
+	ErrDisabledMsg   = errorsmod.Register(ModuleName, 4, "message type is disabled")
+	ErrNestedMsgs    = errorsmod.Register(ModuleName, 5, "too many nested messages")
+)
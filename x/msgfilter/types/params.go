@@ -0,0 +1,132 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+)
+
+// maxGasMultiplier bounds the gas multipliers of the message types.
+var maxGasMultiplier = sdkmath.LegacyNewDec(100)
+
+// NewParams creates a new Params instance.
+func NewParams(disabledMsgs []string, msgFees []MsgFee) Params {
+	return Params{
+		DisabledMsgs: disabledMsgs,
+		MsgFees:      msgFees,
+	}
+}
+
+// DefaultParams returns the default msgfilter parameters, which disable the
+// vesting messages.
+func DefaultParams() Params {
+	return NewParams([]string{
+		sdk.MsgTypeURL(&vestingtypes.MsgCreateVestingAccount{}),
+		sdk.MsgTypeURL(&vestingtypes.MsgCreatePermanentLockedAccount{}),
+		sdk.MsgTypeURL(&vestingtypes.MsgCreatePeriodicVestingAccount{}),
+	}, nil)
+}
+
+// Validate performs a basic validation of the msgfilter parameters. Disabling
+// MsgEthereumTx or MsgUpdateParams would halt the EVM or lock the filter, so
+// neither can be added.
+func (p Params) Validate() error {
+	seen := make(map[string]struct{}, len(p.DisabledMsgs))
+	for _, typeURL := range p.DisabledMsgs {
+		if !strings.HasPrefix(typeURL, "/") || len(typeURL) == 1 {
+			return fmt.Errorf("invalid message type URL %q", typeURL)
+		}
+		switch typeURL {
+		case sdk.MsgTypeURL(&evmtypes.MsgEthereumTx{}), sdk.MsgTypeURL(&MsgUpdateParams{}):
+			return fmt.Errorf("message type %s cannot be disabled", typeURL)
+		}
+		if _, ok := seen[typeURL]; ok {
+			return fmt.Errorf("duplicate message type %s", typeURL)
+		}
+		seen[typeURL] = struct{}{}
+	}
+
+	seen = make(map[string]struct{}, len(p.MsgFees))
+	for _, msgFee := range p.MsgFees {
+		if err := msgFee.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seen[msgFee.TypeUrl]; ok {
+			return fmt.Errorf("duplicate message fee %s", msgFee.TypeUrl)
+		}
+		seen[msgFee.TypeUrl] = struct{}{}
+	}
+	return nil
+}
+
+// IsDisabled reports whether the message type is disabled.
+func (p Params) IsDisabled(typeURL string) bool {
+	for _, disabled := range p.DisabledMsgs {
+		if disabled == typeURL {
+			return true
+		}
+	}
+	return false
+}
+
+// MsgFee returns the minimum fee of the message type, if any.
+func (p Params) MsgFee(typeURL string) (MsgFee, bool) {
+	for _, msgFee := range p.MsgFees {
+		if msgFee.TypeUrl == typeURL {
+			return msgFee, true
+		}
+	}
+	return MsgFee{}, false
+}
+
+// NewMsgFee creates a new MsgFee instance. A zero gas multiplier leaves the
+// gas prices unchanged.
+func NewMsgFee(typeURL string, minFee sdk.Coins, gasMultiplier sdkmath.LegacyDec) MsgFee {
+	return MsgFee{
+		TypeUrl:       typeURL,
+		MinFee:        minFee,
+		GasMultiplier: gasMultiplier,
+	}
+}
+
+// Multiplier returns the gas multiplier of the message type, which is 1 if
+// unset.
+func (m MsgFee) Multiplier() sdkmath.LegacyDec {
+	if m.GasMultiplier.IsNil() || m.GasMultiplier.IsZero() {
+		return sdkmath.LegacyOneDec()
+	}
+	return m.GasMultiplier
+}
+
+// Validate performs a basic validation of the message fee, which must set a
+// minimum fee, a gas multiplier, or both.
+func (m MsgFee) Validate() error {
+	if !strings.HasPrefix(m.TypeUrl, "/") || len(m.TypeUrl) == 1 {
+		return fmt.Errorf("invalid message type URL %q", m.TypeUrl)
+	}
+	if err := m.MinFee.Validate(); err != nil {
+		return fmt.Errorf("invalid minimum fee of %s: %w", m.TypeUrl, err)
+	}
+
+	multiplier := m.GasMultiplier
+	if multiplier.IsNil() || multiplier.IsZero() {
+		if m.MinFee.IsZero() {
+			return fmt.Errorf("message fee of %s sets neither a minimum fee nor a gas multiplier", m.TypeUrl)
+		}
+		return nil
+	}
+	if multiplier.LT(sdkmath.LegacyOneDec()) || multiplier.GT(maxGasMultiplier) {
+		return fmt.Errorf("gas multiplier of %s must be between 1 and %s, got %s", m.TypeUrl, maxGasMultiplier, multiplier)
+	}
+	return nil
+}
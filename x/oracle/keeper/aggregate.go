@@ -0,0 +1,115 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"sort"
+	"strconv"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Asphere-xyz/tacchain/x/oracle/types"
+)
+
+// EventTypePrice is emitted for every aggregated price.
+const EventTypePrice = "oracle_price"
+
+// weightedPrice is a price reported by a validator with its voting power.
+type weightedPrice struct {
+	price sdkmath.LegacyDec
+	power int64
+}
+
+// AggregateVoteExtensions aggregates the prices of the given vote extensions
+// into the stake-weighted median of every pair and stores it. A pair keeps its
+// previous price unless the validators reporting it hold at least
+// MinVotingPower of the voting power of the commit. Invalid vote extensions
+// are skipped.
+//
+// The extended commit must have been validated with
+// baseapp.ValidateVoteExtensions before.
+func (k Keeper) AggregateVoteExtensions(ctx sdk.Context, extCommit abci.ExtendedCommitInfo) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	var totalPower int64
+	observations := make(map[string][]weightedPrice, len(params.Pairs))
+	for _, vote := range extCommit.Votes {
+		totalPower += vote.Validator.Power
+		if vote.BlockIdFlag != cmtproto.BlockIDFlagCommit || len(vote.VoteExtension) == 0 {
+			continue
+		}
+
+		var ve types.OracleVoteExtension
+		if err := ve.Unmarshal(vote.VoteExtension); err != nil {
+			k.Logger(ctx).Debug("skipping undecodable vote extension", "validator", sdk.ConsAddress(vote.Validator.Address), "err", err)
+			continue
+		}
+		if err := ve.ValidateAgainst(params); err != nil {
+			k.Logger(ctx).Debug("skipping invalid vote extension", "validator", sdk.ConsAddress(vote.Validator.Address), "err", err)
+			continue
+		}
+
+		for _, obs := range ve.Prices {
+			observations[obs.Pair] = append(observations[obs.Pair], weightedPrice{price: obs.Price, power: vote.Validator.Power})
+		}
+	}
+	if totalPower <= 0 {
+		return nil
+	}
+
+	for _, pair := range params.Pairs {
+		median, reportedPower, ok := stakeWeightedMedian(observations[pair])
+		if !ok || sdkmath.LegacyNewDec(reportedPower).LT(params.MinVotingPower.MulInt64(totalPower)) {
+			continue
+		}
+
+		price := types.Price{Pair: pair, Price: median, Height: ctx.BlockHeight()}
+		if err := k.SetPrice(ctx, price); err != nil {
+			return err
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			EventTypePrice,
+			sdk.NewAttribute("pair", pair),
+			sdk.NewAttribute("price", median.String()),
+			sdk.NewAttribute("voting_power", strconv.FormatInt(reportedPower, 10)),
+		))
+	}
+	return nil
+}
+
+// stakeWeightedMedian returns the lowest price at which the reporting
+// validators with a price lower or equal hold at least half of the reported
+// voting power, as well as the reported voting power.
+func stakeWeightedMedian(prices []weightedPrice) (sdkmath.LegacyDec, int64, bool) {
+	var reportedPower int64
+	for _, p := range prices {
+		reportedPower += p.power
+	}
+	if reportedPower <= 0 {
+		return sdkmath.LegacyDec{}, 0, false
+	}
+
+	sort.SliceStable(prices, func(i, j int) bool {
+		return prices[i].price.LT(prices[j].price)
+	})
+
+	var cumulative int64
+	for _, p := range prices {
+		cumulative += p.power
+		if 2*cumulative >= reportedPower {
+			return p.price, reportedPower, true
+		}
+	}
+	return prices[len(prices)-1].price, reportedPower, true
+}
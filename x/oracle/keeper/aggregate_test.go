@@ -0,0 +1,70 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper_test
+
+import (
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/Asphere-xyz/tacchain/x/oracle/types"
+)
+
+func newVote(t *testing.T, power int64, prices map[string]string) abci.ExtendedVoteInfo {
+	t.Helper()
+
+	var ve types.OracleVoteExtension
+	for pair, price := range prices {
+		ve.Prices = append(ve.Prices, types.PriceObservation{Pair: pair, Price: sdkmath.LegacyMustNewDecFromStr(price)})
+	}
+	bz, err := ve.Marshal()
+	require.NoError(t, err)
+
+	return abci.ExtendedVoteInfo{
+		Validator:     abci.Validator{Address: []byte{byte(power)}, Power: power},
+		VoteExtension: bz,
+		BlockIdFlag:   cmtproto.BlockIDFlagCommit,
+	}
+}
+
+func TestAggregateVoteExtensions(t *testing.T) {
+	params := types.NewParams([]string{"TAC/USD", "ETH/USD"}, sdkmath.LegacyMustNewDecFromStr("0.5"), types.DefaultTWAPWindow)
+	ctx, k, _ := setupKeeper(t, params)
+
+	absent := newVote(t, 40, map[string]string{"TAC/USD": "9"})
+	absent.BlockIdFlag = cmtproto.BlockIDFlagAbsent
+	invalid := newVote(t, 40, map[string]string{"BTC/USD": "60000"})
+
+	extCommit := abci.ExtendedCommitInfo{Votes: []abci.ExtendedVoteInfo{
+		newVote(t, 10, map[string]string{"TAC/USD": "0.01", "ETH/USD": "3000"}),
+		newVote(t, 30, map[string]string{"TAC/USD": "0.03"}),
+		newVote(t, 20, map[string]string{"TAC/USD": "0.02"}),
+		absent,
+		invalid,
+	}}
+	require.NoError(t, k.AggregateVoteExtensions(ctx, extCommit))
+
+	// 60 of 140 reported TAC/USD, which is below the min voting power
+	_, err := k.Prices.Get(ctx, "TAC/USD")
+	require.Error(t, err)
+
+	params.MinVotingPower = sdkmath.LegacyMustNewDecFromStr("0.4")
+	require.NoError(t, k.Params.Set(ctx, params))
+	require.NoError(t, k.AggregateVoteExtensions(ctx, extCommit))
+
+	// the median weighted by 10, 20 and 30 is the price reported by power 20
+	price, err := k.Prices.Get(ctx, "TAC/USD")
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyMustNewDecFromStr("0.02"), price.Price)
+	require.Equal(t, ctx.BlockHeight(), price.Height)
+
+	// only 10 of 140 reported ETH/USD
+	_, err = k.Prices.Get(ctx, "ETH/USD")
+	require.Error(t, err)
+}
@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"bytes"
+	"math/big"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+
+	"github.com/Asphere-xyz/tacchain/x/oracle/types"
+)
+
+// PriceFeedAddress is the address of the price feed contract that exposes
+// the aggregated prices to EVM contracts, see priceFeedCode for its interface.
+var PriceFeedAddress = common.HexToAddress("0x0000000000000000000000000000000000000900")
+
+// priceFeedCode is the runtime bytecode of the price feed contract. It only
+// implements
+//
+//	function getPrice(bytes32 pairId) external view returns (uint256 price, uint256 height)
+//
+// returning storage slot pairId as the price, scaled by 1e18, and slot
+// pairId + 1 as the height it was aggregated at. Calls with any other
+// selector, short calldata or value revert.
+var priceFeedCode = common.FromHex(
+	"6024361034176000" + // calldatasize < 36 || callvalue
+		"3560e01c6331d98b3f141517" + // || selector != getPrice(bytes32)
+		"602b57" + // jump to revert
+		"6004358054600052" + // mstore(0, sload(pairId))
+		"6001015460205260406000f3" + // mstore(32, sload(pairId + 1)); return(0, 64)
+		"5b600080fd", // revert(0, 0)
+)
+
+// PairID returns the id of a pair in the price feed contract, keccak256(pair).
+func PairID(pair string) common.Hash {
+	return crypto.Keccak256Hash([]byte(pair))
+}
+
+// setEVMPrice writes the price of a pair into the storage of the price feed
+// contract, deploying the contract first if needed.
+func (k Keeper) setEVMPrice(ctx sdk.Context, price types.Price) error {
+	if err := k.ensurePriceFeed(ctx); err != nil {
+		return err
+	}
+
+	id := PairID(price.Pair)
+	heightSlot := common.BigToHash(new(big.Int).Add(id.Big(), big.NewInt(1)))
+	k.evmKeeper.SetState(ctx, PriceFeedAddress, id, common.BigToHash(price.Price.BigInt()).Bytes())
+	k.evmKeeper.SetState(ctx, PriceFeedAddress, heightSlot, common.BigToHash(big.NewInt(price.Height)).Bytes())
+	return nil
+}
+
+// ensurePriceFeed sets the code of the price feed contract unless it is set
+// already.
+func (k Keeper) ensurePriceFeed(ctx sdk.Context) error {
+	codeHash := crypto.Keccak256(priceFeedCode)
+	acc := k.evmKeeper.GetAccount(ctx, PriceFeedAddress)
+	if acc != nil && bytes.Equal(acc.CodeHash, codeHash) {
+		return nil
+	}
+	if acc == nil {
+		acc = statedb.NewEmptyAccount()
+	}
+
+	acc.CodeHash = codeHash
+	k.evmKeeper.SetCode(ctx, codeHash, priceFeedCode)
+	return k.evmKeeper.SetAccount(ctx, PriceFeedAddress, *acc)
+}
@@ -0,0 +1,59 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/rawdb"
+	"github.com/ethereum/go-ethereum/core/state"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm/runtime"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/Asphere-xyz/tacchain/x/oracle/keeper"
+	"github.com/Asphere-xyz/tacchain/x/oracle/types"
+)
+
+func TestPriceFeedContract(t *testing.T) {
+	ctx, k, evmKeeper := setupKeeper(t, types.NewParams([]string{"TAC/USD"}, sdkmath.LegacyMustNewDecFromStr("0.5"), types.DefaultTWAPWindow))
+	require.NoError(t, k.SetPrice(ctx, types.Price{Pair: "TAC/USD", Price: sdkmath.LegacyMustNewDecFromStr("0.0251"), Height: 42}))
+
+	// load the price feed written by the keeper into a geth state
+	stateDB, err := state.New(ethtypes.EmptyRootHash, state.NewDatabase(rawdb.NewMemoryDatabase()), nil)
+	require.NoError(t, err)
+	acc := evmKeeper.GetAccount(ctx, keeper.PriceFeedAddress)
+	require.NotNil(t, acc)
+	stateDB.SetCode(keeper.PriceFeedAddress, evmKeeper.code[common.BytesToHash(acc.CodeHash)])
+	for key, value := range evmKeeper.storage[keeper.PriceFeedAddress] {
+		stateDB.SetState(keeper.PriceFeedAddress, key, value)
+	}
+	cfg := &runtime.Config{State: stateDB}
+
+	selector := crypto.Keccak256([]byte("getPrice(bytes32)"))[:4]
+	pairID := keeper.PairID("TAC/USD")
+
+	ret, _, err := runtime.Call(keeper.PriceFeedAddress, append(selector, pairID.Bytes()...), cfg)
+	require.NoError(t, err)
+	require.Len(t, ret, 64)
+	require.Equal(t, big.NewInt(25100000000000000), new(big.Int).SetBytes(ret[:32]))
+	require.Equal(t, big.NewInt(42), new(big.Int).SetBytes(ret[32:]))
+
+	// unknown pairs read as zero
+	ret, _, err = runtime.Call(keeper.PriceFeedAddress, append(selector, keeper.PairID("BTC/USD").Bytes()...), cfg)
+	require.NoError(t, err)
+	require.Equal(t, make([]byte, 64), ret)
+
+	// other selectors and short calldata revert
+	_, _, err = runtime.Call(keeper.PriceFeedAddress, append([]byte{1, 2, 3, 4}, pairID.Bytes()...), cfg)
+	require.Error(t, err)
+	_, _, err = runtime.Call(keeper.PriceFeedAddress, selector, cfg)
+	require.Error(t, err)
+}
@@ -0,0 +1,44 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Asphere-xyz/tacchain/x/oracle/types"
+)
+
+// InitGenesis initializes the oracle module's state from a given genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) error {
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+
+	for _, price := range gs.Prices {
+		if err := k.SetPrice(ctx, price); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportGenesis returns the oracle module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	prices := []types.Price{}
+	err = k.Prices.Walk(ctx, nil, func(_ string, price types.Price) (bool, error) {
+		prices = append(prices, price)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(params, prices), nil
+}
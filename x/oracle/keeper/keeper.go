@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Asphere-xyz/tacchain/x/oracle/types"
+)
+
+// Keeper of the oracle store
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+	evmKeeper    types.EVMKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically,
+	// this should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// Prices holds the last aggregated price of every pair
+	Prices collections.Map[string, types.Price]
+	// PriceSnapshots holds the prices of every pair aggregated during the last
+	// TWAP window, along with the last one before it
+	PriceSnapshots collections.Map[collections.Pair[string, int64], types.PriceSnapshot]
+}
+
+// NewKeeper returns a new oracle keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	evmKeeper types.EVMKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		evmKeeper:    evmKeeper,
+		authority:    authority,
+		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Prices:       collections.NewMap(sb, types.PricesKey, "prices", collections.StringKey, codec.CollValue[types.Price](cdc)),
+		PriceSnapshots: collections.NewMap(
+			sb, types.PriceSnapshotsKey, "price_snapshots",
+			collections.PairKeyCodec(collections.StringKey, collections.Int64Key), codec.CollValue[types.PriceSnapshot](cdc),
+		),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// SetPrice stores the aggregated price of a pair, records it in the price
+// history and mirrors it into the price feed contract.
+func (k Keeper) SetPrice(ctx sdk.Context, price types.Price) error {
+	if err := k.Prices.Set(ctx, price.Pair, price); err != nil {
+		return err
+	}
+	if err := k.addSnapshot(ctx, price); err != nil {
+		return err
+	}
+	return k.setEVMPrice(ctx, price)
+}
+
+// GetPrice returns the last aggregated price of a pair.
+func (k Keeper) GetPrice(ctx context.Context, pair string) (types.Price, error) {
+	price, err := k.Prices.Get(ctx, pair)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Price{}, errorsmod.Wrapf(types.ErrPriceNotFound, "no price for pair %s", pair)
+	}
+	return price, err
+}
@@ -0,0 +1,83 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/evmos/ethermint/x/evm/statedb"
+
+	"github.com/Asphere-xyz/tacchain/x/oracle/keeper"
+	"github.com/Asphere-xyz/tacchain/x/oracle/types"
+)
+
+// mockEVMKeeper keeps the EVM accounts, code and storage in memory.
+type mockEVMKeeper struct {
+	accounts map[common.Address]statedb.Account
+	code     map[common.Hash][]byte
+	storage  map[common.Address]map[common.Hash]common.Hash
+}
+
+var _ types.EVMKeeper = (*mockEVMKeeper)(nil)
+
+func newMockEVMKeeper() *mockEVMKeeper {
+	return &mockEVMKeeper{
+		accounts: make(map[common.Address]statedb.Account),
+		code:     make(map[common.Hash][]byte),
+		storage:  make(map[common.Address]map[common.Hash]common.Hash),
+	}
+}
+
+func (m *mockEVMKeeper) GetAccount(_ sdk.Context, addr common.Address) *statedb.Account {
+	acc, ok := m.accounts[addr]
+	if !ok {
+		return nil
+	}
+	return &acc
+}
+
+func (m *mockEVMKeeper) GetState(_ sdk.Context, addr common.Address, key common.Hash) common.Hash {
+	return m.storage[addr][key]
+}
+
+func (m *mockEVMKeeper) SetAccount(_ sdk.Context, addr common.Address, account statedb.Account) error {
+	m.accounts[addr] = account
+	return nil
+}
+
+func (m *mockEVMKeeper) SetState(_ sdk.Context, addr common.Address, key common.Hash, value []byte) {
+	if m.storage[addr] == nil {
+		m.storage[addr] = make(map[common.Hash]common.Hash)
+	}
+	m.storage[addr][key] = common.BytesToHash(value)
+}
+
+func (m *mockEVMKeeper) SetCode(_ sdk.Context, codeHash []byte, code []byte) {
+	m.code[common.BytesToHash(codeHash)] = code
+}
+
+func setupKeeper(t *testing.T, params types.Params) (sdk.Context, keeper.Keeper, *mockEVMKeeper) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx.WithBlockHeight(10)
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+
+	evmKeeper := newMockEVMKeeper()
+	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(key), evmKeeper, "authority")
+	require.NoError(t, k.Params.Set(ctx, params))
+
+	return ctx, k, evmKeeper
+}
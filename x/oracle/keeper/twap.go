@@ -0,0 +1,135 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Asphere-xyz/tacchain/x/oracle/types"
+)
+
+// priceSnapshot is a snapshot of the price history of a pair at a height.
+type priceSnapshot struct {
+	height int64
+	types.PriceSnapshot
+}
+
+// cumulativeAt returns the cumulative price of the pair at height, which must
+// not be lower than the height of the snapshot.
+func (s priceSnapshot) cumulativeAt(height int64) sdkmath.LegacyDec {
+	return s.CumulativePrice.Add(s.Price.MulInt64(height - s.height))
+}
+
+// addSnapshot records an aggregated price in the price history of its pair
+// and prunes the snapshots that are no longer needed to compute the TWAP.
+func (k Keeper) addSnapshot(ctx sdk.Context, price types.Price) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	snapshot := types.PriceSnapshot{Price: price.Price, CumulativePrice: sdkmath.LegacyZeroDec()}
+	last, found, err := k.lastSnapshot(ctx, price.Pair, price.Height)
+	if err != nil {
+		return err
+	}
+	if found {
+		snapshot.CumulativePrice = last.cumulativeAt(price.Height)
+	}
+	if err := k.PriceSnapshots.Set(ctx, collections.Join(price.Pair, price.Height), snapshot); err != nil {
+		return err
+	}
+
+	// keep the last snapshot before the window, the TWAP starts in its range
+	start, found, err := k.lastSnapshot(ctx, price.Pair, price.Height-int64(params.TwapWindow))
+	if err != nil || !found {
+		return err
+	}
+	rng := collections.NewPrefixedPairRange[string, int64](price.Pair).EndExclusive(start.height)
+	return k.PriceSnapshots.Clear(ctx, rng)
+}
+
+// lastSnapshot returns the last snapshot of the price history of a pair at or
+// before height.
+func (k Keeper) lastSnapshot(ctx sdk.Context, pair string, height int64) (priceSnapshot, bool, error) {
+	rng := collections.NewPrefixedPairRange[string, int64](pair).EndInclusive(height).Descending()
+	iter, err := k.PriceSnapshots.Iterate(ctx, rng)
+	if err != nil {
+		return priceSnapshot{}, false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return priceSnapshot{}, false, nil
+	}
+	kv, err := iter.KeyValue()
+	if err != nil {
+		return priceSnapshot{}, false, err
+	}
+	return priceSnapshot{height: kv.Key.K2(), PriceSnapshot: kv.Value}, true, nil
+}
+
+// firstSnapshot returns the first snapshot of the price history of a pair.
+func (k Keeper) firstSnapshot(ctx sdk.Context, pair string) (priceSnapshot, bool, error) {
+	iter, err := k.PriceSnapshots.Iterate(ctx, collections.NewPrefixedPairRange[string, int64](pair))
+	if err != nil {
+		return priceSnapshot{}, false, err
+	}
+	defer iter.Close()
+
+	if !iter.Valid() {
+		return priceSnapshot{}, false, nil
+	}
+	kv, err := iter.KeyValue()
+	if err != nil {
+		return priceSnapshot{}, false, err
+	}
+	return priceSnapshot{height: kv.Key.K2(), PriceSnapshot: kv.Value}, true, nil
+}
+
+// GetTWAP returns the time-weighted average price of a pair over the last
+// TwapWindow blocks, each block weighing the last price aggregated before it.
+// The average is computed over the whole price history of the pair if it is
+// shorter than the window, and is the last price if the window is empty.
+func (k Keeper) GetTWAP(goCtx context.Context, pair string) (sdkmath.LegacyDec, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+
+	height := ctx.BlockHeight()
+	last, found, err := k.lastSnapshot(ctx, pair, height)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	if !found {
+		return sdkmath.LegacyDec{}, errorsmod.Wrapf(types.ErrPriceNotFound, "no price for pair %s", pair)
+	}
+
+	startHeight := height - int64(params.TwapWindow)
+	start, found, err := k.lastSnapshot(ctx, pair, startHeight)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	}
+	if !found {
+		if start, _, err = k.firstSnapshot(ctx, pair); err != nil {
+			return sdkmath.LegacyDec{}, err
+		}
+		startHeight = start.height
+	}
+	if startHeight >= height {
+		return last.Price, nil
+	}
+
+	cumulative := last.cumulativeAt(height).Sub(start.cumulativeAt(startHeight))
+	return cumulative.QuoInt64(height - startHeight), nil
+}
@@ -0,0 +1,64 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/Asphere-xyz/tacchain/x/oracle/types"
+)
+
+func TestGetTWAP(t *testing.T) {
+	ctx, k, _ := setupKeeper(t, types.NewParams([]string{"TAC/USD"}, sdkmath.LegacyMustNewDecFromStr("0.5"), 10))
+
+	_, err := k.GetTWAP(ctx, "TAC/USD")
+	require.ErrorIs(t, err, types.ErrPriceNotFound)
+
+	require.NoError(t, k.SetPrice(ctx.WithBlockHeight(100), types.Price{Pair: "TAC/USD", Price: sdkmath.LegacyNewDec(1), Height: 100}))
+	twap, err := k.GetTWAP(ctx.WithBlockHeight(100), "TAC/USD")
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(1), twap)
+
+	// the new price counts from the block it was aggregated at, and the history
+	// is shorter than the window until height 110
+	require.NoError(t, k.SetPrice(ctx.WithBlockHeight(105), types.Price{Pair: "TAC/USD", Price: sdkmath.LegacyNewDec(3), Height: 105}))
+	for height, expected := range map[int64]sdkmath.LegacyDec{
+		105: sdkmath.LegacyNewDec(1),
+		108: sdkmath.LegacyMustNewDecFromStr("1.75"),
+		110: sdkmath.LegacyNewDec(2),
+		112: sdkmath.LegacyMustNewDecFromStr("2.4"),
+		115: sdkmath.LegacyNewDec(3),
+		200: sdkmath.LegacyNewDec(3),
+	} {
+		twap, err := k.GetTWAP(ctx.WithBlockHeight(height), "TAC/USD")
+		require.NoError(t, err)
+		require.Equal(t, expected, twap, "height %d", height)
+	}
+
+	// the snapshots before the window are pruned, but for the one it starts in
+	require.NoError(t, k.SetPrice(ctx.WithBlockHeight(120), types.Price{Pair: "TAC/USD", Price: sdkmath.LegacyNewDec(5), Height: 120}))
+	has, err := k.PriceSnapshots.Has(ctx, collections.Join("TAC/USD", int64(100)))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = k.PriceSnapshots.Has(ctx, collections.Join("TAC/USD", int64(105)))
+	require.NoError(t, err)
+	require.True(t, has)
+	twap, err = k.GetTWAP(ctx.WithBlockHeight(125), "TAC/USD")
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(4), twap)
+
+	// an empty window returns the last price
+	params := types.DefaultParams()
+	params.TwapWindow = 0
+	require.NoError(t, k.Params.Set(ctx, params))
+	twap, err = k.GetTWAP(ctx.WithBlockHeight(125), "TAC/USD")
+	require.NoError(t, err)
+	require.Equal(t, sdkmath.LegacyNewDec(5), twap)
+}
@@ -0,0 +1,97 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Asphere-xyz/tacchain/x/oracle/pricesource"
+	"github.com/Asphere-xyz/tacchain/x/oracle/types"
+)
+
+// VoteExtensionHandler attaches the prices of the local price source to the
+// precommits of the validator and verifies the vote extensions of the other
+// validators.
+type VoteExtensionHandler struct {
+	keeper Keeper
+	source pricesource.PriceSource
+}
+
+// NewVoteExtensionHandler returns a VoteExtensionHandler. A nil source makes
+// the validator extend its votes without prices.
+func NewVoteExtensionHandler(keeper Keeper, source pricesource.PriceSource) *VoteExtensionHandler {
+	return &VoteExtensionHandler{
+		keeper: keeper,
+		source: source,
+	}
+}
+
+// ExtendVoteHandler returns an ExtendVote handler that reports the prices of
+// all pairs in the module parameters known to the price source. Failing to
+// fetch prices is logged and results in an empty vote extension, which does
+// not keep the validator from voting.
+func (h *VoteExtensionHandler) ExtendVoteHandler() sdk.ExtendVoteHandler {
+	return func(ctx sdk.Context, _ *abci.RequestExtendVote) (*abci.ResponseExtendVote, error) {
+		params, err := h.keeper.Params.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+		if h.source == nil || len(params.Pairs) == 0 {
+			return &abci.ResponseExtendVote{VoteExtension: []byte{}}, nil
+		}
+
+		prices, err := h.source.Prices(ctx, params.Pairs)
+		if err != nil {
+			h.keeper.Logger(ctx).Error("failed to fetch prices from price source", "err", err)
+			return &abci.ResponseExtendVote{VoteExtension: []byte{}}, nil
+		}
+
+		var ve types.OracleVoteExtension
+		for _, pair := range params.Pairs {
+			if price, ok := prices[pair]; ok && !price.IsNil() && price.IsPositive() {
+				ve.Prices = append(ve.Prices, types.PriceObservation{Pair: pair, Price: price})
+			}
+		}
+		if len(ve.Prices) == 0 {
+			return &abci.ResponseExtendVote{VoteExtension: []byte{}}, nil
+		}
+
+		bz, err := ve.Marshal()
+		if err != nil {
+			return nil, err
+		}
+		return &abci.ResponseExtendVote{VoteExtension: bz}, nil
+	}
+}
+
+// VerifyVoteExtensionHandler returns a VerifyVoteExtension handler that
+// accepts empty vote extensions and vote extensions reporting positive prices
+// of known pairs only.
+func (h *VoteExtensionHandler) VerifyVoteExtensionHandler() sdk.VerifyVoteExtensionHandler {
+	return func(ctx sdk.Context, req *abci.RequestVerifyVoteExtension) (*abci.ResponseVerifyVoteExtension, error) {
+		if len(req.VoteExtension) == 0 {
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+		}
+
+		params, err := h.keeper.Params.Get(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		var ve types.OracleVoteExtension
+		if err := ve.Unmarshal(req.VoteExtension); err != nil {
+			h.keeper.Logger(ctx).Debug("rejecting undecodable vote extension", "validator", sdk.ConsAddress(req.ValidatorAddress), "err", err)
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+		if err := ve.ValidateAgainst(params); err != nil {
+			h.keeper.Logger(ctx).Debug("rejecting invalid vote extension", "validator", sdk.ConsAddress(req.ValidatorAddress), "err", err)
+			return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_REJECT}, nil
+		}
+
+		return &abci.ResponseVerifyVoteExtension{Status: abci.ResponseVerifyVoteExtension_ACCEPT}, nil
+	}
+}
@@ -0,0 +1,133 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+
+// Package pricesource provides the local price sources validators report
+// prices from in their vote extensions.
+package pricesource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// PriceSource returns the current prices of the requested pairs. Pairs the
+// source has no price for are left out of the result.
+type PriceSource interface {
+	Prices(ctx context.Context, pairs []string) (map[string]sdkmath.LegacyDec, error)
+}
+
+// New returns the price source configured by uri:
+//
+//   - "" disables the price source,
+//   - "file://<path>" reads prices from a JSON file, see FileSource,
+//   - "http://..." and "https://..." fetch prices from a local price feeder,
+//     see HTTPSource.
+func New(uri string, timeout time.Duration) (PriceSource, error) {
+	switch {
+	case uri == "":
+		return nil, nil
+	case strings.HasPrefix(uri, "file://"):
+		return NewFileSource(strings.TrimPrefix(uri, "file://")), nil
+	case strings.HasPrefix(uri, "http://"), strings.HasPrefix(uri, "https://"):
+		return NewHTTPSource(uri, timeout), nil
+	default:
+		return nil, fmt.Errorf("unsupported price source %q", uri)
+	}
+}
+
+// FileSource reads prices from a JSON file mapping pairs to decimal prices,
+// e.g. {"TAC/USD": "0.0251"}. The file is read on every call, so it can be
+// updated while the node is running.
+type FileSource struct {
+	path string
+}
+
+var _ PriceSource = FileSource{}
+
+// NewFileSource returns a FileSource reading from path.
+func NewFileSource(path string) FileSource {
+	return FileSource{path: path}
+}
+
+// Prices implements PriceSource.
+func (s FileSource) Prices(_ context.Context, pairs []string) (map[string]sdkmath.LegacyDec, error) {
+	bz, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, err
+	}
+	return parsePrices(bz, pairs)
+}
+
+// HTTPSource fetches prices from a price feeder serving the JSON format of
+// FileSource with a GET request.
+type HTTPSource struct {
+	url    string
+	client *http.Client
+}
+
+var _ PriceSource = HTTPSource{}
+
+// NewHTTPSource returns an HTTPSource fetching from url. Requests taking
+// longer than timeout are aborted, as they hold up the vote of the validator.
+func NewHTTPSource(url string, timeout time.Duration) HTTPSource {
+	return HTTPSource{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+	}
+}
+
+// Prices implements PriceSource.
+func (s HTTPSource) Prices(ctx context.Context, pairs []string) (map[string]sdkmath.LegacyDec, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("price feeder returned %s", res.Status)
+	}
+
+	bz, err := io.ReadAll(io.LimitReader(res.Body, 1<<20))
+	if err != nil {
+		return nil, err
+	}
+	return parsePrices(bz, pairs)
+}
+
+// parsePrices decodes the prices of the requested pairs from JSON.
+func parsePrices(bz []byte, pairs []string) (map[string]sdkmath.LegacyDec, error) {
+	var raw map[string]string
+	if err := json.Unmarshal(bz, &raw); err != nil {
+		return nil, fmt.Errorf("failed to decode prices: %w", err)
+	}
+
+	prices := make(map[string]sdkmath.LegacyDec, len(pairs))
+	for _, pair := range pairs {
+		s, ok := raw[pair]
+		if !ok {
+			continue
+		}
+		price, err := sdkmath.LegacyNewDecFromStr(s)
+		if err != nil {
+			return nil, fmt.Errorf("invalid price of pair %s: %w", pair, err)
+		}
+		prices[pair] = price
+	}
+	return prices, nil
+}
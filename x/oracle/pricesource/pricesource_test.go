@@ -0,0 +1,60 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package pricesource
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+func TestNew(t *testing.T) {
+	source, err := New("", time.Second)
+	require.NoError(t, err)
+	require.Nil(t, source)
+
+	source, err = New("file:///tmp/prices.json", time.Second)
+	require.NoError(t, err)
+	require.IsType(t, FileSource{}, source)
+
+	source, err = New("https://localhost:8080/prices", time.Second)
+	require.NoError(t, err)
+	require.IsType(t, HTTPSource{}, source)
+
+	_, err = New("ftp://localhost/prices", time.Second)
+	require.Error(t, err)
+}
+
+func TestFileSource(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "prices.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"TAC/USD": "0.0251", "BTC/USD": "60000"}`), 0o600))
+
+	prices, err := NewFileSource(path).Prices(context.Background(), []string{"TAC/USD", "ETH/USD"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]sdkmath.LegacyDec{"TAC/USD": sdkmath.LegacyMustNewDecFromStr("0.0251")}, prices)
+
+	require.NoError(t, os.WriteFile(path, []byte(`{"TAC/USD": "cheap"}`), 0o600))
+	_, err = NewFileSource(path).Prices(context.Background(), []string{"TAC/USD"})
+	require.Error(t, err)
+}
+
+func TestHTTPSource(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(`{"TAC/USD": "0.0251"}`))
+	}))
+	defer srv.Close()
+
+	prices, err := NewHTTPSource(srv.URL, time.Second).Prices(context.Background(), []string{"TAC/USD"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]sdkmath.LegacyDec{"TAC/USD": sdkmath.LegacyMustNewDecFromStr("0.0251")}, prices)
+}
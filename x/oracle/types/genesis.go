@@ -0,0 +1,53 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, prices []Price) *GenesisState {
+	return &GenesisState{
+		Params: params,
+		Prices: prices,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the oracle module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []Price{})
+}
+
+// Validate performs a basic validation of the genesis state.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(gs.Prices))
+	for _, price := range gs.Prices {
+		if err := price.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seen[price.Pair]; ok {
+			return fmt.Errorf("duplicate price for pair %s", price.Pair)
+		}
+		seen[price.Pair] = struct{}{}
+	}
+	return nil
+}
+
+// Validate performs a basic validation of an aggregated price.
+func (p Price) Validate() error {
+	if err := ValidatePair(p.Pair); err != nil {
+		return err
+	}
+	if p.Price.IsNil() || !p.Price.IsPositive() {
+		return fmt.Errorf("price of pair %s must be positive", p.Pair)
+	}
+	if p.Height < 0 {
+		return fmt.Errorf("height of pair %s must not be negative", p.Pair)
+	}
+	return nil
+}
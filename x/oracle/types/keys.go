@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "oracle"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	// ParamsKey is the prefix of the module parameters
+	ParamsKey = collections.NewPrefix(0)
+
+	// PricesKey is the prefix of the aggregated prices, keyed by pair
+	PricesKey = collections.NewPrefix(1)
+
+	// PriceSnapshotsKey is the prefix of the price history, keyed by pair and
+	// height
+	PriceSnapshotsKey = collections.NewPrefix(2)
+)
@@ -0,0 +1,85 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import (
+	"fmt"
+	"regexp"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// maxPairLength is the maximum length of a price pair name.
+const maxPairLength = 32
+
+// pairRegex matches price pair names such as "TAC/USD".
+var pairRegex = regexp.MustCompile(`^[A-Za-z0-9]+/[A-Za-z0-9]+$`)
+
+// DefaultMinVotingPower is the default share of the voting power that must
+// report a price for its median to be stored.
+var DefaultMinVotingPower = sdkmath.LegacyNewDecWithPrec(5, 1)
+
+const (
+	// DefaultTWAPWindow is the default number of blocks the time-weighted
+	// average prices are computed over.
+	DefaultTWAPWindow uint64 = 100
+	// MaxTWAPWindow bounds the price history kept for every pair.
+	MaxTWAPWindow uint64 = 100_000
+)
+
+// NewParams creates a new Params instance.
+func NewParams(pairs []string, minVotingPower sdkmath.LegacyDec, twapWindow uint64) Params {
+	return Params{
+		Pairs:          pairs,
+		MinVotingPower: minVotingPower,
+		TwapWindow:     twapWindow,
+	}
+}
+
+// DefaultParams returns the default oracle parameters. No pair is reported
+// until governance adds one.
+func DefaultParams() Params {
+	return NewParams([]string{}, DefaultMinVotingPower, DefaultTWAPWindow)
+}
+
+// Validate performs a basic validation of the oracle parameters.
+func (p Params) Validate() error {
+	seen := make(map[string]struct{}, len(p.Pairs))
+	for _, pair := range p.Pairs {
+		if err := ValidatePair(pair); err != nil {
+			return err
+		}
+		if _, ok := seen[pair]; ok {
+			return fmt.Errorf("duplicate pair %s", pair)
+		}
+		seen[pair] = struct{}{}
+	}
+
+	if p.MinVotingPower.IsNil() || p.MinVotingPower.IsNegative() || p.MinVotingPower.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("min voting power must be between 0 and 1, got %s", p.MinVotingPower)
+	}
+	if p.TwapWindow > MaxTWAPWindow {
+		return fmt.Errorf("twap window must be at most %d blocks, got %d", MaxTWAPWindow, p.TwapWindow)
+	}
+	return nil
+}
+
+// HasPair reports whether the pair is reported by validators.
+func (p Params) HasPair(pair string) bool {
+	for _, pp := range p.Pairs {
+		if pp == pair {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidatePair checks that a price pair name has the form "BASE/QUOTE".
+func ValidatePair(pair string) error {
+	if len(pair) > maxPairLength || !pairRegex.MatchString(pair) {
+		return fmt.Errorf("invalid pair %q, expected BASE/QUOTE", pair)
+	}
+	return nil
+}
@@ -0,0 +1,29 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ValidateAgainst checks that a vote extension only reports positive prices
+// of known pairs, at most once per pair.
+func (ve OracleVoteExtension) ValidateAgainst(params Params) error {
+	seen := make(map[string]struct{}, len(ve.Prices))
+	for _, obs := range ve.Prices {
+		if !params.HasPair(obs.Pair) {
+			return errorsmod.Wrapf(ErrInvalidVoteExtension, "unknown pair %s", obs.Pair)
+		}
+		if _, ok := seen[obs.Pair]; ok {
+			return errorsmod.Wrapf(ErrInvalidVoteExtension, "duplicate pair %s", obs.Pair)
+		}
+		seen[obs.Pair] = struct{}{}
+
+		if obs.Price.IsNil() || !obs.Price.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidVoteExtension, "price of pair %s must be positive", obs.Pair)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package revenue
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	"github.com/Asphere-xyz/tacchain/x/revenue/types"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Query_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current revenue parameters",
+				},
+				{
+					RpcMethod:      "Revenue",
+					Use:            "revenue [contract]",
+					Short:          "Query the registration and the accrued revenue of a contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract"}},
+				},
+				{
+					RpcMethod: "Revenues",
+					Use:       "revenues",
+					Short:     "Query all the registered contracts",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Msg_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "RegisterRevenue",
+					Use:            "register-revenue [contract]",
+					Short:          "Register a contract deployed or administered by the sender for a share of the fees of the txs calling it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_address"}},
+				},
+				{
+					RpcMethod:      "UpdateRevenue",
+					Use:            "update-revenue [contract] [withdrawer]",
+					Short:          "Change the withdrawer of a registered contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_address"}, {ProtoField: "withdrawer_address"}},
+				},
+				{
+					RpcMethod:      "CancelRevenue",
+					Use:            "cancel-revenue [contract]",
+					Short:          "Remove the registration of a contract and withdraw its revenue",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_address"}},
+				},
+				{
+					RpcMethod:      "WithdrawRevenue",
+					Use:            "withdraw-revenue [contract]",
+					Short:          "Withdraw the accrued revenue of a contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract_address"}},
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
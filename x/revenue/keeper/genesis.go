@@ -0,0 +1,76 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Asphere-xyz/tacchain/x/revenue/types"
+)
+
+// InitGenesis initializes the revenue module's state from a given genesis
+// state, and creates the module account holding the accrued revenues.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) error {
+	k.accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+	for _, revenue := range gs.Revenues {
+		contract, err := types.ParseContract(revenue.ContractAddress)
+		if err != nil {
+			return err
+		}
+		revenue.ContractAddress = contract.String()
+		if err := k.Revenues.Set(ctx, contract, revenue); err != nil {
+			return err
+		}
+	}
+	for _, accrued := range gs.Accrued {
+		contract, err := types.ParseContract(accrued.ContractAddress)
+		if err != nil {
+			return err
+		}
+		if err := k.addAccrued(ctx, contract, accrued.Amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportGenesis returns the revenue module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var revenues []types.Revenue
+	err = k.Revenues.Walk(ctx, nil, func(_ []byte, revenue types.Revenue) (bool, error) {
+		revenues = append(revenues, revenue)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var accrued []types.AccruedRevenue
+	err = k.Accrued.Walk(ctx, nil, func(key collections.Pair[[]byte, string], amount sdkmath.Int) (bool, error) {
+		contract := sdk.AccAddress(key.K1())
+		coin := sdk.NewCoin(key.K2(), amount)
+		if n := len(accrued); n > 0 && accrued[n-1].ContractAddress == contract.String() {
+			accrued[n-1].Amount = append(accrued[n-1].Amount, coin)
+		} else {
+			accrued = append(accrued, types.AccruedRevenue{ContractAddress: contract.String(), Amount: sdk.Coins{coin}})
+		}
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return types.NewGenesisState(params, revenues, accrued), nil
+}
@@ -0,0 +1,143 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Asphere-xyz/tacchain/x/revenue/types"
+)
+
+// Keeper of the revenue store
+type Keeper struct {
+	cdc           codec.BinaryCodec
+	storeService  store.KVStoreService
+	accountKeeper types.AccountKeeper
+	bankKeeper    types.BankKeeper
+	evmKeeper     types.EVMKeeper
+	wasmKeeper    types.WasmKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically,
+	// this should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// Revenues holds the registration of every registered contract
+	Revenues collections.Map[[]byte, types.Revenue]
+	// Accrued holds the revenues not withdrawn yet by contract and denom
+	Accrued collections.Map[collections.Pair[[]byte, string], sdkmath.Int]
+}
+
+// NewKeeper returns a new revenue keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	evmKeeper types.EVMKeeper,
+	wasmKeeper types.WasmKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:           cdc,
+		storeService:  storeService,
+		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
+		evmKeeper:     evmKeeper,
+		wasmKeeper:    wasmKeeper,
+		authority:     authority,
+		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Revenues:      collections.NewMap(sb, types.RevenuesKey, "revenues", collections.BytesKey, codec.CollValue[types.Revenue](cdc)),
+		Accrued: collections.NewMap(sb, types.AccruedKey, "accrued",
+			collections.PairKeyCodec(collections.BytesKey, collections.StringKey), sdk.IntValue),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetRevenue returns the registration of contract, and false if the contract
+// isn't registered.
+func (k Keeper) GetRevenue(ctx context.Context, contract sdk.AccAddress) (types.Revenue, bool, error) {
+	revenue, err := k.Revenues.Get(ctx, contract)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.Revenue{}, false, nil
+	}
+	return revenue, err == nil, err
+}
+
+// GetAccrued returns the revenue of contract not withdrawn yet.
+func (k Keeper) GetAccrued(ctx context.Context, contract sdk.AccAddress) (sdk.Coins, error) {
+	var accrued sdk.Coins
+	rng := collections.NewPrefixedPairRange[[]byte, string](contract)
+	err := k.Accrued.Walk(ctx, rng, func(key collections.Pair[[]byte, string], amount sdkmath.Int) (bool, error) {
+		accrued = append(accrued, sdk.NewCoin(key.K2(), amount))
+		return false, nil
+	})
+	return accrued, err
+}
+
+// addAccrued adds coins to the revenue of contract not withdrawn yet.
+func (k Keeper) addAccrued(ctx context.Context, contract sdk.AccAddress, coins sdk.Coins) error {
+	for _, coin := range coins {
+		key := collections.Join([]byte(contract), coin.Denom)
+		accrued, err := k.Accrued.Get(ctx, key)
+		if errors.Is(err, collections.ErrNotFound) {
+			accrued = sdkmath.ZeroInt()
+		} else if err != nil {
+			return err
+		}
+		if err := k.Accrued.Set(ctx, key, accrued.Add(coin.Amount)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// withdraw pays the revenue of contract not withdrawn yet to withdrawer.
+func (k Keeper) withdraw(ctx context.Context, contract, withdrawer sdk.AccAddress) (sdk.Coins, error) {
+	accrued, err := k.GetAccrued(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+	for _, coin := range accrued {
+		if err := k.Accrued.Remove(ctx, collections.Join([]byte(contract), coin.Denom)); err != nil {
+			return nil, err
+		}
+	}
+	if accrued.IsZero() {
+		return accrued, nil
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, withdrawer, accrued); err != nil {
+		return nil, err
+	}
+	return accrued, nil
+}
@@ -0,0 +1,287 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/Asphere-xyz/tacchain/x/revenue/keeper"
+	"github.com/Asphere-xyz/tacchain/x/revenue/types"
+)
+
+var (
+	deployer   = sdk.AccAddress(common.HexToAddress("0x1000000000000000000000000000000000000001").Bytes())
+	withdrawer = sdk.AccAddress(common.HexToAddress("0x2000000000000000000000000000000000000002").Bytes())
+	// evmContract is created by a contract that deployer created with its
+	// nonce 3, with the nonce 1 of that contract
+	evmContract  = crypto.CreateAddress(crypto.CreateAddress(common.BytesToAddress(deployer), 3), 1)
+	wasmContract = sdk.AccAddress(bytes.Repeat([]byte{2}, 32))
+	// noAdminContract is a CosmWasm contract without admin created by deployer
+	noAdminContract = sdk.AccAddress(bytes.Repeat([]byte{3}, 32))
+)
+
+// mockAccountKeeper keeps the module accounts in memory.
+type mockAccountKeeper map[string]sdk.ModuleAccountI
+
+var _ types.AccountKeeper = mockAccountKeeper(nil)
+
+func (m mockAccountKeeper) GetModuleAccount(_ context.Context, moduleName string) sdk.ModuleAccountI {
+	if _, ok := m[moduleName]; !ok {
+		m[moduleName] = authtypes.NewEmptyModuleAccount(moduleName)
+	}
+	return m[moduleName]
+}
+
+// mockBankKeeper keeps the balances of the module accounts and of the
+// withdrawers in memory.
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+var _ types.BankKeeper = (*mockBankKeeper)(nil)
+
+func (m *mockBankKeeper) SendCoinsFromModuleToModule(_ context.Context, sender, recipient string, amt sdk.Coins) error {
+	m.balances[sender] = m.balances[sender].Sub(amt...)
+	m.balances[recipient] = m.balances[recipient].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, sender string, recipient sdk.AccAddress, amt sdk.Coins) error {
+	m.balances[sender] = m.balances[sender].Sub(amt...)
+	m.balances[recipient.String()] = m.balances[recipient.String()].Add(amt...)
+	return nil
+}
+
+// mockEVMKeeper holds the EVM accounts.
+type mockEVMKeeper map[common.Address]*statedb.Account
+
+var _ types.EVMKeeper = mockEVMKeeper(nil)
+
+func (m mockEVMKeeper) GetAccount(_ sdk.Context, addr common.Address) *statedb.Account {
+	return m[addr]
+}
+
+// mockWasmKeeper holds the CosmWasm contracts by address.
+type mockWasmKeeper map[string]*wasmtypes.ContractInfo
+
+var _ types.WasmKeeper = mockWasmKeeper(nil)
+
+func (m mockWasmKeeper) GetContractInfo(_ context.Context, contractAddress sdk.AccAddress) *wasmtypes.ContractInfo {
+	return m[contractAddress.String()]
+}
+
+func setupKeeper(t *testing.T, params types.Params) (sdk.Context, keeper.Keeper, *mockBankKeeper) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+
+	bankKeeper := &mockBankKeeper{balances: map[string]sdk.Coins{}}
+	evmKeeper := mockEVMKeeper{
+		evmContract:                       &statedb.Account{CodeHash: crypto.Keccak256([]byte{1})},
+		common.BytesToAddress(withdrawer): statedb.NewEmptyAccount(),
+	}
+	wasmKeeper := mockWasmKeeper{
+		wasmContract.String():    &wasmtypes.ContractInfo{Creator: withdrawer.String(), Admin: deployer.String()},
+		noAdminContract.String(): &wasmtypes.ContractInfo{Creator: deployer.String()},
+	}
+	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(key), mockAccountKeeper{}, bankKeeper, evmKeeper, wasmKeeper, "authority")
+	require.NoError(t, k.Params.Set(ctx, params))
+	return ctx, k, bankKeeper
+}
+
+func TestRegisterRevenue(t *testing.T) {
+	testCases := []struct {
+		name     string
+		contract string
+		deployer sdk.AccAddress
+		nonces   []uint64
+		err      error
+	}{
+		{"evm contract", evmContract.Hex(), deployer, []uint64{3, 1}, nil},
+		{"evm contract by bech32 address", sdk.AccAddress(evmContract.Bytes()).String(), deployer, []uint64{3, 1}, nil},
+		{"wrong nonces", evmContract.Hex(), deployer, []uint64{3, 2}, types.ErrUnauthorized},
+		{"no nonces", evmContract.Hex(), deployer, nil, types.ErrInvalidContract},
+		{"not the deployer", evmContract.Hex(), withdrawer, []uint64{3, 1}, types.ErrUnauthorized},
+		{"not a contract", common.BytesToAddress(withdrawer).Hex(), deployer, []uint64{0}, types.ErrInvalidContract},
+		{"unknown account", common.HexToAddress("0x3").Hex(), deployer, []uint64{0}, types.ErrInvalidContract},
+		{"invalid address", "0x3", deployer, nil, types.ErrInvalidContract},
+		{"wasm contract by admin", wasmContract.String(), deployer, nil, nil},
+		{"wasm contract by creator", wasmContract.String(), withdrawer, nil, types.ErrUnauthorized},
+		{"wasm contract without admin by creator", noAdminContract.String(), deployer, nil, nil},
+		{"wasm contract with nonces", wasmContract.String(), deployer, []uint64{0}, types.ErrInvalidContract},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, k, _ := setupKeeper(t, types.DefaultParams())
+			msgServer := keeper.NewMsgServerImpl(k)
+
+			_, err := msgServer.RegisterRevenue(ctx, types.NewMsgRegisterRevenue(tc.contract, tc.deployer, nil, tc.nonces))
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+
+			contract, err := types.ParseContract(tc.contract)
+			require.NoError(t, err)
+			res, err := keeper.NewQueryServerImpl(k).Revenue(ctx, &types.QueryRevenueRequest{Contract: tc.contract})
+			require.NoError(t, err)
+			require.Equal(t, types.NewRevenue(contract, tc.deployer, tc.deployer), res.Revenue)
+			require.Empty(t, res.Accrued)
+
+			_, err = msgServer.RegisterRevenue(ctx, types.NewMsgRegisterRevenue(tc.contract, tc.deployer, nil, tc.nonces))
+			require.ErrorIs(t, err, types.ErrRegistered)
+		})
+	}
+}
+
+func TestDistributeRevenue(t *testing.T) {
+	ctx, k, bankKeeper := setupKeeper(t, types.NewParams(sdkmath.LegacyNewDecWithPrec(5, 1)))
+	msgServer := keeper.NewMsgServerImpl(k)
+	_, err := msgServer.RegisterRevenue(ctx, types.NewMsgRegisterRevenue(evmContract.Hex(), deployer, withdrawer, []uint64{3, 1}))
+	require.NoError(t, err)
+	_, err = msgServer.RegisterRevenue(ctx, types.NewMsgRegisterRevenue(wasmContract.String(), deployer, nil, nil))
+	require.NoError(t, err)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin("utac", 1201), sdk.NewInt64Coin("uatom", 3))
+	bankKeeper.balances[authtypes.FeeCollectorName] = fees
+
+	// half of the fees is shared by the 3 messages, and the unregistered
+	// contract and the message calling no contract earn nothing
+	contracts := []sdk.AccAddress{evmContract.Bytes(), noAdminContract, nil}
+	paid, err := k.DistributeRevenue(ctx, contracts, fees)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("utac", 200)), paid)
+	require.Equal(t, fees.Sub(paid...), bankKeeper.balances[authtypes.FeeCollectorName])
+	require.Equal(t, paid, bankKeeper.balances[types.ModuleName])
+
+	// a single message gets the whole share
+	paid, err = k.DistributeRevenue(ctx, []sdk.AccAddress{evmContract.Bytes()}, fees)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("utac", 600), sdk.NewInt64Coin("uatom", 1)), paid)
+
+	paid, err = k.DistributeRevenue(ctx, []sdk.AccAddress{wasmContract, evmContract.Bytes()}, sdk.NewCoins(sdk.NewInt64Coin("utac", 100)))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("utac", 50)), paid)
+
+	paid, err = k.DistributeRevenue(ctx, nil, fees)
+	require.NoError(t, err)
+	require.True(t, paid.IsZero())
+
+	// governance can turn the revenue off
+	require.NoError(t, k.Params.Set(ctx, types.NewParams(sdkmath.LegacyZeroDec())))
+	paid, err = k.DistributeRevenue(ctx, []sdk.AccAddress{evmContract.Bytes()}, fees)
+	require.NoError(t, err)
+	require.True(t, paid.IsZero())
+
+	queryServer := keeper.NewQueryServerImpl(k)
+	res, err := queryServer.Revenue(ctx, &types.QueryRevenueRequest{Contract: evmContract.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("utac", 825), sdk.NewInt64Coin("uatom", 1)), res.Accrued)
+	res, err = queryServer.Revenue(ctx, &types.QueryRevenueRequest{Contract: wasmContract.String()})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("utac", 25)), res.Accrued)
+	_, err = queryServer.Revenue(ctx, &types.QueryRevenueRequest{Contract: noAdminContract.String()})
+	require.Error(t, err)
+
+	revenues, err := queryServer.Revenues(ctx, &types.QueryRevenuesRequest{})
+	require.NoError(t, err)
+	require.Len(t, revenues.Revenues, 2)
+}
+
+func TestWithdrawRevenue(t *testing.T) {
+	ctx, k, bankKeeper := setupKeeper(t, types.NewParams(sdkmath.LegacyOneDec()))
+	msgServer := keeper.NewMsgServerImpl(k)
+	contract := evmContract.Hex()
+	_, err := msgServer.RegisterRevenue(ctx, types.NewMsgRegisterRevenue(contract, deployer, nil, []uint64{3, 1}))
+	require.NoError(t, err)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin("utac", 1000))
+	bankKeeper.balances[authtypes.FeeCollectorName] = fees.Add(fees...)
+	_, err = k.DistributeRevenue(ctx, []sdk.AccAddress{evmContract.Bytes()}, fees)
+	require.NoError(t, err)
+
+	// only the deployer changes the withdrawer, and only the withdrawer
+	// withdraws
+	_, err = msgServer.UpdateRevenue(ctx, types.NewMsgUpdateRevenue(contract, withdrawer, withdrawer))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = msgServer.UpdateRevenue(ctx, types.NewMsgUpdateRevenue(contract, deployer, withdrawer))
+	require.NoError(t, err)
+	_, err = msgServer.WithdrawRevenue(ctx, types.NewMsgWithdrawRevenue(contract, deployer))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	res, err := msgServer.WithdrawRevenue(ctx, types.NewMsgWithdrawRevenue(contract, withdrawer))
+	require.NoError(t, err)
+	require.Equal(t, fees, res.Amount)
+	require.Equal(t, fees, bankKeeper.balances[withdrawer.String()])
+	require.True(t, bankKeeper.balances[types.ModuleName].IsZero())
+
+	res, err = msgServer.WithdrawRevenue(ctx, types.NewMsgWithdrawRevenue(contract, withdrawer))
+	require.NoError(t, err)
+	require.True(t, res.Amount.IsZero())
+
+	// cancelling pays the accrued revenue to the withdrawer
+	_, err = k.DistributeRevenue(ctx, []sdk.AccAddress{evmContract.Bytes()}, fees)
+	require.NoError(t, err)
+	_, err = msgServer.CancelRevenue(ctx, types.NewMsgCancelRevenue(contract, withdrawer))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = msgServer.CancelRevenue(ctx, types.NewMsgCancelRevenue(contract, deployer))
+	require.NoError(t, err)
+	require.Equal(t, fees.Add(fees...), bankKeeper.balances[withdrawer.String()])
+
+	_, err = msgServer.WithdrawRevenue(ctx, types.NewMsgWithdrawRevenue(contract, withdrawer))
+	require.ErrorIs(t, err, types.ErrNotRegistered)
+	paid, err := k.DistributeRevenue(ctx, []sdk.AccAddress{evmContract.Bytes()}, fees)
+	require.NoError(t, err)
+	require.True(t, paid.IsZero())
+}
+
+func TestGenesis(t *testing.T) {
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+
+	accountKeeper := mockAccountKeeper{}
+	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(key), accountKeeper, nil, nil, nil, "authority")
+
+	gs := types.NewGenesisState(types.DefaultParams(), []types.Revenue{
+		types.NewRevenue(wasmContract, deployer, deployer),
+		types.NewRevenue(evmContract.Bytes(), deployer, withdrawer),
+	}, []types.AccruedRevenue{
+		{ContractAddress: sdk.AccAddress(evmContract.Bytes()).String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1), sdk.NewInt64Coin("utac", 2))},
+		{ContractAddress: wasmContract.String(), Amount: sdk.NewCoins(sdk.NewInt64Coin("utac", 3))},
+	})
+	require.NoError(t, gs.Validate())
+	require.NoError(t, k.InitGenesis(ctx, *gs))
+	require.Contains(t, accountKeeper, types.ModuleName)
+
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, gs, exported)
+
+	// accrued revenues must belong to registered contracts
+	gs.Revenues = gs.Revenues[1:]
+	require.Error(t, gs.Validate())
+}
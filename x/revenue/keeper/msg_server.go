@@ -0,0 +1,167 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Asphere-xyz/tacchain/x/revenue/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	k Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface for
+// the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return msgServer{k: k}
+}
+
+// RegisterRevenue registers a contract deployed or administered by the signer.
+// The withdrawer defaults to the signer.
+func (m msgServer) RegisterRevenue(ctx context.Context, msg *types.MsgRegisterRevenue) (*types.MsgRegisterRevenueResponse, error) {
+	deployer, err := sdk.AccAddressFromBech32(msg.DeployerAddress)
+	if err != nil {
+		return nil, err
+	}
+	withdrawer := deployer
+	if msg.WithdrawerAddress != "" {
+		if withdrawer, err = sdk.AccAddressFromBech32(msg.WithdrawerAddress); err != nil {
+			return nil, err
+		}
+	}
+	contract, err := types.ParseContract(msg.ContractAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidContract, err.Error())
+	}
+
+	_, found, err := m.k.GetRevenue(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+	if found {
+		return nil, errorsmod.Wrapf(types.ErrRegistered, "contract %s", contract)
+	}
+	if err := m.k.checkDeployer(sdk.UnwrapSDKContext(ctx), contract, deployer, msg.Nonces); err != nil {
+		return nil, err
+	}
+
+	if err := m.k.Revenues.Set(ctx, contract, types.NewRevenue(contract, deployer, withdrawer)); err != nil {
+		return nil, err
+	}
+	return &types.MsgRegisterRevenueResponse{}, nil
+}
+
+// UpdateRevenue changes the withdrawer of a contract registered by the signer.
+func (m msgServer) UpdateRevenue(ctx context.Context, msg *types.MsgUpdateRevenue) (*types.MsgUpdateRevenueResponse, error) {
+	withdrawer, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress)
+	if err != nil {
+		return nil, err
+	}
+	contract, revenue, err := m.registeredBy(ctx, msg.ContractAddress, msg.DeployerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	revenue.WithdrawerAddress = withdrawer.String()
+	if err := m.k.Revenues.Set(ctx, contract, revenue); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateRevenueResponse{}, nil
+}
+
+// CancelRevenue removes the registration of a contract registered by the
+// signer, and pays its accrued revenue to its withdrawer.
+func (m msgServer) CancelRevenue(ctx context.Context, msg *types.MsgCancelRevenue) (*types.MsgCancelRevenueResponse, error) {
+	contract, revenue, err := m.registeredBy(ctx, msg.ContractAddress, msg.DeployerAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := m.k.withdraw(ctx, contract, sdk.MustAccAddressFromBech32(revenue.WithdrawerAddress)); err != nil {
+		return nil, err
+	}
+	if err := m.k.Revenues.Remove(ctx, contract); err != nil {
+		return nil, err
+	}
+	return &types.MsgCancelRevenueResponse{}, nil
+}
+
+// WithdrawRevenue pays the accrued revenue of a contract to its withdrawer,
+// the signer.
+func (m msgServer) WithdrawRevenue(ctx context.Context, msg *types.MsgWithdrawRevenue) (*types.MsgWithdrawRevenueResponse, error) {
+	withdrawer, err := sdk.AccAddressFromBech32(msg.WithdrawerAddress)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := types.ParseContract(msg.ContractAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidContract, err.Error())
+	}
+
+	revenue, found, err := m.k.GetRevenue(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrNotRegistered, "contract %s", contract)
+	}
+	if revenue.WithdrawerAddress != withdrawer.String() {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the withdrawer of contract %s", withdrawer, contract)
+	}
+
+	amount, err := m.k.withdraw(ctx, contract, withdrawer)
+	if err != nil {
+		return nil, err
+	}
+	return &types.MsgWithdrawRevenueResponse{Amount: amount}, nil
+}
+
+// UpdateParams updates the module parameters.
+func (m msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if m.k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	if err := m.k.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// registeredBy returns the registration of a contract registered by deployer.
+func (m msgServer) registeredBy(ctx context.Context, contractAddress, deployerAddress string) (sdk.AccAddress, types.Revenue, error) {
+	deployer, err := sdk.AccAddressFromBech32(deployerAddress)
+	if err != nil {
+		return nil, types.Revenue{}, err
+	}
+	contract, err := types.ParseContract(contractAddress)
+	if err != nil {
+		return nil, types.Revenue{}, errorsmod.Wrap(types.ErrInvalidContract, err.Error())
+	}
+
+	revenue, found, err := m.k.GetRevenue(ctx, contract)
+	if err != nil {
+		return nil, types.Revenue{}, err
+	}
+	if !found {
+		return nil, types.Revenue{}, errorsmod.Wrapf(types.ErrNotRegistered, "contract %s", contract)
+	}
+	if revenue.DeployerAddress != deployer.String() {
+		return nil, types.Revenue{}, errorsmod.Wrapf(types.ErrUnauthorized, "%s did not register contract %s", deployer, contract)
+	}
+	return contract, revenue, nil
+}
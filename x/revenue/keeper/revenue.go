@@ -0,0 +1,104 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	deployertypes "github.com/Asphere-xyz/tacchain/x/deployer/types"
+	"github.com/Asphere-xyz/tacchain/x/revenue/types"
+)
+
+const (
+	// EventTypeRevenue is emitted with the revenue of a contract from a tx.
+	EventTypeRevenue = "revenue"
+
+	AttributeKeyContract = "contract"
+	AttributeKeyAmount   = "amount"
+)
+
+// DistributeRevenue pays, from the fee collector, the developer share of the
+// fees of a tx to the registered contracts called by its messages: contracts
+// holds the contract called by each message, or nil for the messages calling
+// none, and each message gets an equal part of the share. It returns the
+// revenue paid.
+func (k Keeper) DistributeRevenue(ctx sdk.Context, contracts []sdk.AccAddress, fees sdk.Coins) (sdk.Coins, error) {
+	if len(contracts) == 0 || fees.IsZero() {
+		return nil, nil
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	perMsg := sdk.NewCoins()
+	for _, coin := range fees {
+		amount := params.DeveloperShare.MulInt(coin.Amount).TruncateInt().QuoRaw(int64(len(contracts)))
+		perMsg = perMsg.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+	if perMsg.IsZero() {
+		return nil, nil
+	}
+
+	paid := sdk.NewCoins()
+	for _, contract := range contracts {
+		if contract == nil {
+			continue
+		}
+		_, found, err := k.GetRevenue(ctx, contract)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			continue
+		}
+		if err := k.addAccrued(ctx, contract, perMsg); err != nil {
+			return nil, err
+		}
+		paid = paid.Add(perMsg...)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			EventTypeRevenue,
+			sdk.NewAttribute(AttributeKeyContract, contract.String()),
+			sdk.NewAttribute(AttributeKeyAmount, perMsg.String()),
+		))
+	}
+	if paid.IsZero() {
+		return nil, nil
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, authtypes.FeeCollectorName, types.ModuleName, paid); err != nil {
+		return nil, err
+	}
+	return paid, nil
+}
+
+// checkDeployer checks that deployer is the admin of the CosmWasm contract, or
+// its creator if it has no admin, or that it deployed the EVM contract through
+// the chain of CREATE calls with the given nonces.
+func (k Keeper) checkDeployer(ctx sdk.Context, contract, deployer sdk.AccAddress, nonces []uint64) error {
+	if info := k.wasmKeeper.GetContractInfo(ctx, contract); info != nil {
+		if len(nonces) != 0 {
+			return errorsmod.Wrap(types.ErrInvalidContract, "nonces of a CosmWasm contract")
+		}
+		admin := info.Admin
+		if admin == "" {
+			admin = info.Creator
+		}
+		if admin != deployer.String() {
+			return errorsmod.Wrapf(types.ErrUnauthorized, "%s is not the admin of contract %s", deployer, contract)
+		}
+		return nil
+	}
+
+	if len(contract) != common.AddressLength {
+		return errorsmod.Wrapf(types.ErrInvalidContract, "%s is not a contract", contract)
+	}
+	return deployertypes.CheckDeployer(ctx, k.evmKeeper, common.BytesToAddress(contract), deployer, nonces, types.ErrInvalidContract, types.ErrUnauthorized)
+}
@@ -0,0 +1,17 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// x/revenue module sentinel errors
+var (
+	ErrInvalidSigner   = errorsmod.Register(ModuleName, 2, "expected gov account as only signer for proposal message")
+	ErrInvalidParams   = errorsmod.Register(ModuleName, 3, "invalid revenue params")
+	ErrInvalidContract = errorsmod.Register(ModuleName, 4, "invalid contract")
+	ErrRegistered      = errorsmod.Register(ModuleName, 5, "contract already registered")
+	ErrNotRegistered   = errorsmod.Register(ModuleName, 6, "contract not registered")
+	ErrUnauthorized    = errorsmod.Register(ModuleName, 7, "unauthorized")
+)
@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, revenues []Revenue, accrued []AccruedRevenue) *GenesisState {
+	return &GenesisState{
+		Params:   params,
+		Revenues: revenues,
+		Accrued:  accrued,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the revenue
+// module.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), nil, nil)
+}
+
+// Validate performs a basic validation of the genesis state. The accrued
+// revenues must belong to registered contracts.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	registered := make(map[string]bool, len(gs.Revenues))
+	for _, revenue := range gs.Revenues {
+		if err := revenue.Validate(); err != nil {
+			return err
+		}
+		contract, _ := ParseContract(revenue.ContractAddress)
+		if registered[contract.String()] {
+			return fmt.Errorf("duplicate revenue for contract %s", revenue.ContractAddress)
+		}
+		registered[contract.String()] = true
+	}
+
+	accrued := make(map[string]bool, len(gs.Accrued))
+	for _, revenue := range gs.Accrued {
+		contract, err := ParseContract(revenue.ContractAddress)
+		if err != nil {
+			return err
+		}
+		if !registered[contract.String()] {
+			return fmt.Errorf("accrued revenue of unregistered contract %s", revenue.ContractAddress)
+		}
+		if accrued[contract.String()] {
+			return fmt.Errorf("duplicate accrued revenue for contract %s", revenue.ContractAddress)
+		}
+		accrued[contract.String()] = true
+		if err := revenue.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid accrued revenue of contract %s: %w", revenue.ContractAddress, err)
+		}
+	}
+	return nil
+}
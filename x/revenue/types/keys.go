@@ -0,0 +1,24 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import "cosmossdk.io/collections"
+
+const (
+	// ModuleName defines the module name
+	ModuleName = "revenue"
+
+	// StoreKey defines the primary module store key
+	StoreKey = ModuleName
+)
+
+var (
+	// ParamsKey is the prefix of the module parameters
+	ParamsKey = collections.NewPrefix(0)
+	// RevenuesKey is the prefix of the registered contracts
+	RevenuesKey = collections.NewPrefix(1)
+	// AccruedKey is the prefix of the accrued revenues by contract and denom
+	AccruedKey = collections.NewPrefix(2)
+)
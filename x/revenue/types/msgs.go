@@ -0,0 +1,61 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+var (
+	_ sdk.Msg = &MsgRegisterRevenue{}
+	_ sdk.Msg = &MsgUpdateRevenue{}
+	_ sdk.Msg = &MsgCancelRevenue{}
+	_ sdk.Msg = &MsgWithdrawRevenue{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// NewMsgRegisterRevenue creates a new MsgRegisterRevenue instance.
+func NewMsgRegisterRevenue(contract string, deployer, withdrawer sdk.AccAddress, nonces []uint64) *MsgRegisterRevenue {
+	msg := &MsgRegisterRevenue{
+		ContractAddress: contract,
+		DeployerAddress: deployer.String(),
+		Nonces:          nonces,
+	}
+	if withdrawer != nil {
+		msg.WithdrawerAddress = withdrawer.String()
+	}
+	return msg
+}
+
+// NewMsgUpdateRevenue creates a new MsgUpdateRevenue instance.
+func NewMsgUpdateRevenue(contract string, deployer, withdrawer sdk.AccAddress) *MsgUpdateRevenue {
+	return &MsgUpdateRevenue{
+		ContractAddress:   contract,
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawer.String(),
+	}
+}
+
+// NewMsgCancelRevenue creates a new MsgCancelRevenue instance.
+func NewMsgCancelRevenue(contract string, deployer sdk.AccAddress) *MsgCancelRevenue {
+	return &MsgCancelRevenue{
+		ContractAddress: contract,
+		DeployerAddress: deployer.String(),
+	}
+}
+
+// NewMsgWithdrawRevenue creates a new MsgWithdrawRevenue instance.
+func NewMsgWithdrawRevenue(contract string, withdrawer sdk.AccAddress) *MsgWithdrawRevenue {
+	return &MsgWithdrawRevenue{
+		ContractAddress:   contract,
+		WithdrawerAddress: withdrawer.String(),
+	}
+}
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance.
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+)
+
+// DefaultDeveloperShare is the default share of the fees paid to the
+// registered contracts.
+var DefaultDeveloperShare = sdkmath.LegacyNewDecWithPrec(5, 1)
+
+// NewParams creates a new Params instance.
+func NewParams(developerShare sdkmath.LegacyDec) Params {
+	return Params{
+		DeveloperShare: developerShare,
+	}
+}
+
+// DefaultParams returns the default revenue parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultDeveloperShare)
+}
+
+// Validate performs a basic validation of the revenue parameters.
+func (p Params) Validate() error {
+	if p.DeveloperShare.IsNil() || p.DeveloperShare.IsNegative() || p.DeveloperShare.GT(sdkmath.LegacyOneDec()) {
+		return fmt.Errorf("developer share must be between 0 and 1: %s", p.DeveloperShare)
+	}
+	return nil
+}
@@ -0,0 +1,48 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NewRevenue creates a new Revenue instance.
+func NewRevenue(contract, deployer, withdrawer sdk.AccAddress) Revenue {
+	return Revenue{
+		ContractAddress:   contract.String(),
+		DeployerAddress:   deployer.String(),
+		WithdrawerAddress: withdrawer.String(),
+	}
+}
+
+// ParseContract parses the bech32 address of a contract, or the hex address of
+// an EVM contract.
+func ParseContract(contract string) (sdk.AccAddress, error) {
+	if common.IsHexAddress(contract) {
+		return common.HexToAddress(contract).Bytes(), nil
+	}
+	addr, err := sdk.AccAddressFromBech32(contract)
+	if err != nil {
+		return nil, fmt.Errorf("invalid contract address %q: %w", contract, err)
+	}
+	return addr, nil
+}
+
+// Validate performs a basic validation of the revenue.
+func (r Revenue) Validate() error {
+	if _, err := ParseContract(r.ContractAddress); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(r.DeployerAddress); err != nil {
+		return fmt.Errorf("invalid deployer address %s: %w", r.DeployerAddress, err)
+	}
+	if _, err := sdk.AccAddressFromBech32(r.WithdrawerAddress); err != nil {
+		return fmt.Errorf("invalid withdrawer address %s: %w", r.WithdrawerAddress, err)
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Asphere-xyz/tacchain/x/smartaccount/types"
+)
+
+// InitGenesis initializes the smartaccount module's state from a given genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) error {
+	if err := k.Params.Set(ctx, gs.Params); err != nil {
+		return err
+	}
+	for _, s := range gs.Accounts {
+		addr, err := types.ParseAddress(s)
+		if err != nil {
+			return err
+		}
+		if err := k.Accounts.Set(ctx, addr); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportGenesis returns the smartaccount module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) (*types.GenesisState, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := []string{}
+	err = k.Accounts.Walk(ctx, nil, func(addr []byte) (bool, error) {
+		accounts = append(accounts, sdk.AccAddress(addr).String())
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return types.NewGenesisState(params, accounts), nil
+}
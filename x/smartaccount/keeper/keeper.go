@@ -0,0 +1,100 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Asphere-xyz/tacchain/x/smartaccount/types"
+)
+
+// Keeper of the smartaccount store
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+	evmKeeper    types.EVMKeeper
+	wasmKeeper   types.WasmKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically,
+	// this should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	Params collections.Item[types.Params]
+	// Accounts holds the smart accounts
+	Accounts collections.KeySet[[]byte]
+}
+
+// NewKeeper returns a new smartaccount keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	evmKeeper types.EVMKeeper,
+	wasmKeeper types.WasmKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		evmKeeper:    evmKeeper,
+		wasmKeeper:   wasmKeeper,
+		authority:    authority,
+		Params:       collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Accounts:     collections.NewKeySet(sb, types.AccountsKey, "accounts", collections.BytesKey),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// IsSmartAccount reports whether the account is a smart account.
+func (k Keeper) IsSmartAccount(ctx context.Context, addr sdk.AccAddress) (bool, error) {
+	return k.Accounts.Has(ctx, addr)
+}
+
+// IsContract reports whether the account is an EVM contract, with code, or a
+// CosmWasm contract.
+func (k Keeper) IsContract(ctx sdk.Context, addr sdk.AccAddress) bool {
+	if len(addr) == common.AddressLength {
+		if acc := k.evmKeeper.GetAccount(ctx, common.BytesToAddress(addr)); acc != nil && acc.IsContract() {
+			return true
+		}
+	}
+	return k.wasmKeeper.HasContractInfo(ctx, addr)
+}
+
+// MaxValidationGas returns the gas budget of the validateTransaction hook.
+func (k Keeper) MaxValidationGas(ctx context.Context) (uint64, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return params.MaxValidationGas, nil
+}
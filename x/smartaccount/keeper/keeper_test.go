@@ -0,0 +1,199 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/Asphere-xyz/tacchain/x/smartaccount/keeper"
+	"github.com/Asphere-xyz/tacchain/x/smartaccount/types"
+)
+
+var (
+	evmWallet  = sdk.AccAddress(common.HexToAddress("0x1000000000000000000000000000000000000001").Bytes())
+	wasmWallet = sdk.AccAddress(crypto.Keccak256([]byte("wasm wallet")))
+	eoa        = sdk.AccAddress(common.HexToAddress("0x2000000000000000000000000000000000000002").Bytes())
+)
+
+// mockEVMKeeper has the code of evmWallet, records the applied messages and
+// returns a fixed response.
+type mockEVMKeeper struct {
+	msgs []core.Message
+	res  *evmtypes.MsgEthereumTxResponse
+}
+
+var _ types.EVMKeeper = (*mockEVMKeeper)(nil)
+
+func (m *mockEVMKeeper) GetAccount(_ sdk.Context, addr common.Address) *statedb.Account {
+	if addr == common.BytesToAddress(evmWallet) {
+		return &statedb.Account{CodeHash: crypto.Keccak256([]byte("code"))}
+	}
+	return statedb.NewEmptyAccount()
+}
+
+func (m *mockEVMKeeper) ApplyMessage(_ sdk.Context, msg core.Message, _ vm.EVMLogger, commit bool) (*evmtypes.MsgEthereumTxResponse, error) {
+	if commit {
+		panic("committed validation")
+	}
+	m.msgs = append(m.msgs, msg)
+	return m.res, nil
+}
+
+// mockWasmKeeper has the contract wasmWallet, records the sudo messages and
+// consumes a fixed amount of gas.
+type mockWasmKeeper struct {
+	msgs [][]byte
+	gas  storetypes.Gas
+	err  error
+}
+
+var _ types.WasmKeeper = (*mockWasmKeeper)(nil)
+
+func (m *mockWasmKeeper) HasContractInfo(_ context.Context, contractAddress sdk.AccAddress) bool {
+	return contractAddress.Equals(wasmWallet)
+}
+
+func (m *mockWasmKeeper) Sudo(ctx context.Context, _ sdk.AccAddress, msg []byte) ([]byte, error) {
+	m.msgs = append(m.msgs, msg)
+	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(m.gas, "wasm")
+	return nil, m.err
+}
+
+func setupKeeper(t *testing.T, params types.Params) (sdk.Context, keeper.Keeper, *mockEVMKeeper, *mockWasmKeeper) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+
+	evmKeeper := &mockEVMKeeper{}
+	wasmKeeper := &mockWasmKeeper{}
+	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(key), evmKeeper, wasmKeeper, "authority")
+	require.NoError(t, k.Params.Set(ctx, params))
+	return ctx, k, evmKeeper, wasmKeeper
+}
+
+func TestRegister(t *testing.T) {
+	ctx, k, _, _ := setupKeeper(t, types.DefaultParams())
+	msgServer := keeper.NewMsgServerImpl(k)
+	queryServer := keeper.NewQueryServerImpl(k)
+
+	// only contracts can be smart accounts
+	_, err := msgServer.Register(ctx, types.NewMsgRegister(eoa))
+	require.ErrorIs(t, err, types.ErrNotContract)
+
+	for _, account := range []sdk.AccAddress{evmWallet, wasmWallet} {
+		_, err = msgServer.Register(ctx, types.NewMsgRegister(account))
+		require.NoError(t, err)
+		_, err = msgServer.Register(ctx, types.NewMsgRegister(account))
+		require.ErrorIs(t, err, types.ErrRegistered)
+	}
+
+	res, err := queryServer.Account(ctx, &types.QueryAccountRequest{Address: common.BytesToAddress(evmWallet).Hex()})
+	require.NoError(t, err)
+	require.True(t, res.SmartAccount)
+	accounts, err := queryServer.Accounts(ctx, &types.QueryAccountsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{evmWallet.String(), wasmWallet.String()}, accounts.Accounts)
+
+	gs, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, accounts.Accounts, gs.Accounts)
+	require.NoError(t, gs.Validate())
+
+	_, err = msgServer.Unregister(ctx, types.NewMsgUnregister(evmWallet))
+	require.NoError(t, err)
+	_, err = msgServer.Unregister(ctx, types.NewMsgUnregister(evmWallet))
+	require.ErrorIs(t, err, types.ErrNotRegistered)
+	res, err = queryServer.Account(ctx, &types.QueryAccountRequest{Address: evmWallet.String()})
+	require.NoError(t, err)
+	require.False(t, res.SmartAccount)
+}
+
+func TestValidateTransaction(t *testing.T) {
+	signBytes, signature := []byte("sign bytes"), []byte("signature")
+	approved := common.RightPadBytes(types.ValidateTransactionSelector, 32)
+	// only the gas of the hooks is counted
+	noStoreGas := storetypes.GasConfig{}
+
+	t.Run("evm", func(t *testing.T) {
+		ctx, k, evmKeeper, _ := setupKeeper(t, types.DefaultParams())
+		ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).WithKVGasConfig(noStoreGas)
+
+		evmKeeper.res = &evmtypes.MsgEthereumTxResponse{GasUsed: 30_000, Ret: approved}
+		require.NoError(t, k.ValidateTransaction(ctx, evmWallet, signBytes, signature))
+		require.Equal(t, storetypes.Gas(30_000), ctx.GasMeter().GasConsumed())
+
+		require.Len(t, evmKeeper.msgs, 1)
+		msg := evmKeeper.msgs[0]
+		wallet := common.BytesToAddress(evmWallet)
+		require.Equal(t, wallet, msg.From)
+		require.Equal(t, &wallet, msg.To)
+		require.Equal(t, types.DefaultMaxValidationGas, msg.GasLimit)
+		data, err := types.PackValidateTransaction(signBytes, signature)
+		require.NoError(t, err)
+		require.Equal(t, data, msg.Data)
+		require.Equal(t, crypto.Keccak256(signBytes), msg.Data[4:36])
+
+		// the txs are rejected unless the hook returns its selector
+		for _, ret := range [][]byte{nil, types.ValidateTransactionSelector, data[:32]} {
+			evmKeeper.res = &evmtypes.MsgEthereumTxResponse{GasUsed: 30_000, Ret: ret}
+			require.ErrorIs(t, k.ValidateTransaction(ctx, evmWallet, signBytes, signature), types.ErrValidationFailed)
+		}
+		evmKeeper.res = &evmtypes.MsgEthereumTxResponse{GasUsed: 30_000, VmError: vm.ErrExecutionReverted.Error()}
+		require.ErrorIs(t, k.ValidateTransaction(ctx, evmWallet, signBytes, signature), types.ErrValidationFailed)
+	})
+
+	t.Run("wasm", func(t *testing.T) {
+		ctx, k, _, wasmKeeper := setupKeeper(t, types.DefaultParams())
+		ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).WithKVGasConfig(noStoreGas)
+
+		wasmKeeper.gas = 40_000
+		require.NoError(t, k.ValidateTransaction(ctx, wasmWallet, signBytes, signature))
+		require.Equal(t, storetypes.Gas(40_000), ctx.GasMeter().GasConsumed())
+		require.Len(t, wasmKeeper.msgs, 1)
+		var msg types.ValidateTransactionSudoMsg
+		require.NoError(t, json.Unmarshal(wasmKeeper.msgs[0], &msg))
+		require.Equal(t, types.ValidateTransaction{SignBytes: signBytes, Signature: signature}, msg.ValidateTransaction)
+
+		// the hook is bounded by the gas budget, which is charged to the tx
+		ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		wasmKeeper.gas = types.DefaultMaxValidationGas + 1
+		require.ErrorIs(t, k.ValidateTransaction(ctx, wasmWallet, signBytes, signature), types.ErrValidationFailed)
+		require.Equal(t, types.DefaultMaxValidationGas, ctx.GasMeter().GasConsumed())
+	})
+
+	t.Run("budget", func(t *testing.T) {
+		require.Error(t, types.NewParams(types.MaxValidationGasLimit+1).Validate())
+
+		ctx, k, evmKeeper, _ := setupKeeper(t, types.NewParams(types.MaxValidationGasLimit))
+		ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).WithKVGasConfig(noStoreGas)
+		evmKeeper.res = &evmtypes.MsgEthereumTxResponse{GasUsed: 30_000, Ret: approved}
+		require.NoError(t, k.ValidateTransaction(ctx, evmWallet, signBytes, signature))
+		require.Equal(t, types.MaxValidationGasLimit, evmKeeper.msgs[0].GasLimit)
+	})
+
+	t.Run("disabled", func(t *testing.T) {
+		ctx, k, _, _ := setupKeeper(t, types.NewParams(0))
+		require.ErrorIs(t, k.ValidateTransaction(ctx, wasmWallet, signBytes, signature), types.ErrDisabled)
+	})
+}
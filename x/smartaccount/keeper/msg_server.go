@@ -0,0 +1,101 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Asphere-xyz/tacchain/x/smartaccount/types"
+)
+
+const (
+	// EventTypeRegister is emitted when an account is marked as a smart account.
+	EventTypeRegister = "smart_account_registered"
+	// EventTypeUnregister is emitted when a smart account is unmarked.
+	EventTypeUnregister = "smart_account_unregistered"
+
+	AttributeKeyAccount = "account"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	k Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface for
+// the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return msgServer{k: k}
+}
+
+// Register marks a contract account as a smart account.
+func (m msgServer) Register(goCtx context.Context, msg *types.MsgRegister) (*types.MsgRegisterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	account, err := sdk.AccAddressFromBech32(msg.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	if !m.k.IsContract(ctx, account) {
+		return nil, errorsmod.Wrapf(types.ErrNotContract, "%s", account)
+	}
+	registered, err := m.k.IsSmartAccount(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	if registered {
+		return nil, errorsmod.Wrapf(types.ErrRegistered, "%s", account)
+	}
+
+	if err := m.k.Accounts.Set(ctx, account); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeRegister, sdk.NewAttribute(AttributeKeyAccount, account.String())))
+	return &types.MsgRegisterResponse{}, nil
+}
+
+// Unregister unmarks a smart account.
+func (m msgServer) Unregister(goCtx context.Context, msg *types.MsgUnregister) (*types.MsgUnregisterResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	account, err := sdk.AccAddressFromBech32(msg.Account)
+	if err != nil {
+		return nil, err
+	}
+
+	registered, err := m.k.IsSmartAccount(ctx, account)
+	if err != nil {
+		return nil, err
+	}
+	if !registered {
+		return nil, errorsmod.Wrapf(types.ErrNotRegistered, "%s", account)
+	}
+
+	if err := m.k.Accounts.Remove(ctx, account); err != nil {
+		return nil, err
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(EventTypeUnregister, sdk.NewAttribute(AttributeKeyAccount, account.String())))
+	return &types.MsgUnregisterResponse{}, nil
+}
+
+// UpdateParams updates the module parameters.
+func (m msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if m.k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	if err := m.k.Params.Set(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+	return &types.MsgUpdateParamsResponse{}, nil
+}
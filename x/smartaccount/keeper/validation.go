@@ -0,0 +1,109 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"errors"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core"
+	"github.com/ethereum/go-ethereum/core/vm"
+	evmtypes "github.com/evmos/ethermint/x/evm/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Asphere-xyz/tacchain/x/smartaccount/types"
+)
+
+// ValidateTransaction calls the hook of account to validate a tx, given its
+// sign bytes and the signature of the account. The hook runs within the gas
+// budget set by governance, which is charged to the tx, and its state changes
+// are discarded.
+func (k Keeper) ValidateTransaction(ctx sdk.Context, account sdk.AccAddress, signBytes, signature []byte) error {
+	gasLimit, err := k.MaxValidationGas(ctx)
+	if err != nil {
+		return err
+	}
+	if gasLimit == 0 {
+		return types.ErrDisabled
+	}
+
+	cacheCtx, _ := ctx.CacheContext()
+	if k.wasmKeeper.HasContractInfo(ctx, account) {
+		return k.validateWasm(cacheCtx, ctx.GasMeter(), account, signBytes, signature, gasLimit)
+	}
+	if len(account) != common.AddressLength {
+		return errorsmod.Wrapf(types.ErrNotContract, "%s", account)
+	}
+	return k.validateEVM(cacheCtx, ctx.GasMeter(), common.BytesToAddress(account), signBytes, signature, gasLimit)
+}
+
+// validateEVM calls the validateTransaction function of an EVM smart account,
+// from the account itself.
+func (k Keeper) validateEVM(ctx sdk.Context, txGasMeter storetypes.GasMeter, account common.Address, signBytes, signature []byte, gasLimit uint64) error {
+	data, err := types.PackValidateTransaction(signBytes, signature)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrValidationFailed, err.Error())
+	}
+	msg := core.Message{
+		From:      account,
+		To:        &account,
+		Value:     new(big.Int),
+		GasLimit:  gasLimit,
+		GasPrice:  new(big.Int),
+		GasFeeCap: new(big.Int),
+		GasTipCap: new(big.Int),
+		Data:      data,
+	}
+
+	// the store accesses of the EVM are charged as EVM gas
+	res, err := k.evmKeeper.ApplyMessage(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()), msg, nil, false)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrValidationFailed, err.Error())
+	}
+	txGasMeter.ConsumeGas(res.GasUsed, "smart account validation")
+	if res.Failed() {
+		if res.VmError == vm.ErrExecutionReverted.Error() {
+			err = evmtypes.NewExecErrorWithReason(res.Ret)
+		} else {
+			err = errors.New(res.VmError)
+		}
+		return errorsmod.Wrap(types.ErrValidationFailed, err.Error())
+	}
+	if !types.IsValidationApproved(res.Ret) {
+		return errorsmod.Wrapf(types.ErrValidationFailed, "validateTransaction returned 0x%x", res.Ret)
+	}
+	return nil
+}
+
+// validateWasm calls the validate_transaction sudo entry point of a CosmWasm
+// smart account.
+func (k Keeper) validateWasm(ctx sdk.Context, txGasMeter storetypes.GasMeter, account sdk.AccAddress, signBytes, signature []byte, gasLimit uint64) (err error) {
+	msg, err := types.NewValidateTransactionSudoMsg(signBytes, signature)
+	if err != nil {
+		return errorsmod.Wrap(types.ErrValidationFailed, err.Error())
+	}
+
+	gasMeter := storetypes.NewGasMeter(gasLimit)
+	defer func() {
+		if r := recover(); r != nil {
+			outOfGas, ok := r.(storetypes.ErrorOutOfGas)
+			if !ok {
+				panic(r)
+			}
+			err = errorsmod.Wrapf(types.ErrValidationFailed, "out of gas in location: %s", outOfGas.Descriptor)
+		}
+		txGasMeter.ConsumeGas(gasMeter.GasConsumedToLimit(), "smart account validation")
+	}()
+
+	if _, err := k.wasmKeeper.Sudo(ctx.WithGasMeter(gasMeter), account, msg); err != nil {
+		return errorsmod.Wrap(types.ErrValidationFailed, err.Error())
+	}
+	return nil
+}
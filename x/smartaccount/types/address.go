@@ -0,0 +1,27 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ParseAddress parses the address of an account in hex, for EVM contracts, or
+// in bech32.
+func ParseAddress(s string) (sdk.AccAddress, error) {
+	if common.IsHexAddress(s) {
+		return common.HexToAddress(s).Bytes(), nil
+	}
+
+	addr, err := sdk.AccAddressFromBech32(s)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidAddress, "%q is neither a hex nor a bech32 address", s)
+	}
+	return addr, nil
+}
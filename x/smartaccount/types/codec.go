@@ -0,0 +1,34 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the necessary x/smartaccount interfaces
+// and concrete types on the provided LegacyAmino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgRegister{}, "tacchain/x/smartaccount/MsgRegister")
+	legacy.RegisterAminoMsg(cdc, &MsgUnregister{}, "tacchain/x/smartaccount/MsgUnregister")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "tacchain/x/smartaccount/MsgUpdateParams")
+	cdc.RegisterConcrete(&Params{}, "tacchain/x/smartaccount/Params", nil)
+}
+
+// RegisterInterfaces registers the x/smartaccount interfaces types with the
+// interface registry.
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRegister{},
+		&MsgUnregister{},
+		&MsgUpdateParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
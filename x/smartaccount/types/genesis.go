@@ -0,0 +1,41 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(params Params, accounts []string) *GenesisState {
+	return &GenesisState{
+		Params:   params,
+		Accounts: accounts,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the smartaccount
+// module, with no smart accounts.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState(DefaultParams(), []string{})
+}
+
+// Validate performs a basic validation of the genesis state.
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	seen := make(map[string]struct{}, len(gs.Accounts))
+	for _, s := range gs.Accounts {
+		addr, err := ParseAddress(s)
+		if err != nil {
+			return err
+		}
+		if _, ok := seen[string(addr)]; ok {
+			return fmt.Errorf("duplicate smart account %s", s)
+		}
+		seen[string(addr)] = struct{}{}
+	}
+	return nil
+}
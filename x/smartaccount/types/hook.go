@@ -0,0 +1,75 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ValidateTransactionSelector is the selector of the hook of the EVM smart
+// accounts:
+//
+//	function validateTransaction(bytes32 txHash, bytes signature) external returns (bytes4 magic);
+//
+// txHash is the Keccak-256 hash of the SIGN_MODE_DIRECT sign bytes of the tx,
+// and signature the signature of the tx. The hook approves the tx by returning
+// its selector, and rejects it by returning anything else or by reverting.
+var ValidateTransactionSelector = crypto.Keccak256([]byte("validateTransaction(bytes32,bytes)"))[:4]
+
+var validateTransactionArgs = abi.Arguments{
+	{Type: mustNewType("bytes32")},
+	{Type: mustNewType("bytes")},
+}
+
+func mustNewType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
+
+// PackValidateTransaction returns the calldata of the validateTransaction hook
+// of an EVM smart account.
+func PackValidateTransaction(signBytes, signature []byte) ([]byte, error) {
+	args, err := validateTransactionArgs.Pack(crypto.Keccak256Hash(signBytes), signature)
+	if err != nil {
+		return nil, err
+	}
+	return append(bytes.Clone(ValidateTransactionSelector), args...), nil
+}
+
+// IsValidationApproved reports whether ret, returned by the validateTransaction
+// hook, is the ABI encoding of its selector. The encoding is checked in full,
+// so that a fallback echoing its calldata doesn't approve the txs.
+func IsValidationApproved(ret []byte) bool {
+	return bytes.Equal(ret, common.RightPadBytes(ValidateTransactionSelector, 32))
+}
+
+// ValidateTransactionSudoMsg is the sudo message of the hook of the CosmWasm
+// smart accounts, which approve a tx by returning successfully.
+type ValidateTransactionSudoMsg struct {
+	ValidateTransaction ValidateTransaction `json:"validate_transaction"`
+}
+
+// ValidateTransaction holds the SIGN_MODE_DIRECT sign bytes and the signature
+// of the tx validated by a CosmWasm smart account, in base64.
+type ValidateTransaction struct {
+	SignBytes []byte `json:"sign_bytes"`
+	Signature []byte `json:"signature"`
+}
+
+// NewValidateTransactionSudoMsg returns the JSON sudo message of the
+// validate_transaction hook of a CosmWasm smart account.
+func NewValidateTransactionSudoMsg(signBytes, signature []byte) ([]byte, error) {
+	return json.Marshal(ValidateTransactionSudoMsg{
+		ValidateTransaction: ValidateTransaction{SignBytes: signBytes, Signature: signature},
+	})
+}
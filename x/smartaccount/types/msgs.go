@@ -0,0 +1,35 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+var (
+	_ sdk.Msg = &MsgRegister{}
+	_ sdk.Msg = &MsgUnregister{}
+	_ sdk.Msg = &MsgUpdateParams{}
+)
+
+// NewMsgRegister creates a new MsgRegister instance.
+func NewMsgRegister(account sdk.AccAddress) *MsgRegister {
+	return &MsgRegister{
+		Account: account.String(),
+	}
+}
+
+// NewMsgUnregister creates a new MsgUnregister instance.
+func NewMsgUnregister(account sdk.AccAddress) *MsgUnregister {
+	return &MsgUnregister{
+		Account: account.String(),
+	}
+}
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance.
+func NewMsgUpdateParams(authority string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import "fmt"
+
+// DefaultMaxValidationGas is the default gas budget of the validateTransaction
+// hook, enough for a few signature verifications.
+const DefaultMaxValidationGas uint64 = 250_000
+
+// MaxValidationGasLimit caps the gas budget of the validateTransaction hook,
+// which runs before the fees of the txs are known to be paid.
+const MaxValidationGasLimit uint64 = 5_000_000
+
+// NewParams creates a new Params instance.
+func NewParams(maxValidationGas uint64) Params {
+	return Params{
+		MaxValidationGas: maxValidationGas,
+	}
+}
+
+// DefaultParams returns the default smartaccount parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultMaxValidationGas)
+}
+
+// Validate performs a basic validation of the smartaccount parameters. A zero
+// gas budget disables the smart accounts.
+func (p Params) Validate() error {
+	if p.MaxValidationGas > MaxValidationGasLimit {
+		return fmt.Errorf("max validation gas must not exceed %d: %d", MaxValidationGasLimit, p.MaxValidationGas)
+	}
+	return nil
+}
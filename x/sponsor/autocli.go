@@ -0,0 +1,54 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package sponsor
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	"github.com/Asphere-xyz/tacchain/x/sponsor/types"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Query_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "Sponsor",
+					Use:            "sponsor [contract]",
+					Short:          "Query the sponsor of a contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract"}},
+				},
+				{
+					RpcMethod: "Sponsorships",
+					Use:       "sponsorships",
+					Short:     "Query all the sponsored contracts",
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Msg_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "SponsorContract",
+					Use:            "sponsor-contract [contract]",
+					Short:          "Pay the fees of the Ethereum txs calling a contract deployed by the sender from the fee allowances it granted",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract"}},
+				},
+				{
+					RpcMethod:      "UnsponsorContract",
+					Use:            "unsponsor-contract [contract]",
+					Short:          "Stop sponsoring a contract",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "contract"}},
+				},
+				{
+					RpcMethod: "SetSponsor",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,45 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/Asphere-xyz/tacchain/x/sponsor/types"
+)
+
+// InitGenesis initializes the sponsor module's state from a given genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) error {
+	for _, s := range gs.Sponsorships {
+		contract, err := types.ParseContract(s.Contract)
+		if err != nil {
+			return err
+		}
+		granter, err := sdk.AccAddressFromBech32(s.Granter)
+		if err != nil {
+			return err
+		}
+		if err := k.SetSponsor(ctx, contract, granter); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ExportGenesis returns the sponsor module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) (*types.GenesisState, error) {
+	sponsorships := []types.Sponsorship{}
+	err := k.Sponsors.Walk(ctx, nil, func(contract, granter []byte) (bool, error) {
+		sponsorships = append(sponsorships, types.NewSponsorship(common.BytesToAddress(contract), granter))
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewGenesisState(sponsorships), nil
+}
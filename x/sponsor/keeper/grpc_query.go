@@ -0,0 +1,65 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/Asphere-xyz/tacchain/x/sponsor/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+type queryServer struct {
+	k Keeper
+}
+
+// NewQueryServerImpl returns an implementation of the QueryServer interface
+// for the provided Keeper.
+func NewQueryServerImpl(k Keeper) types.QueryServer {
+	return queryServer{k: k}
+}
+
+// Sponsor returns the sponsor of a contract.
+func (q queryServer) Sponsor(ctx context.Context, req *types.QuerySponsorRequest) (*types.QuerySponsorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	contract, err := types.ParseContract(req.Contract)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	granter, err := q.k.GetSponsor(ctx, contract)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if granter == nil {
+		return nil, status.Errorf(codes.NotFound, "contract %s is not sponsored", contract)
+	}
+	return &types.QuerySponsorResponse{Granter: granter.String()}, nil
+}
+
+// Sponsorships returns all the sponsored contracts.
+func (q queryServer) Sponsorships(ctx context.Context, req *types.QuerySponsorshipsRequest) (*types.QuerySponsorshipsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sponsorships, pageRes, err := query.CollectionPaginate(ctx, q.k.Sponsors, req.Pagination, func(contract, granter []byte) (types.Sponsorship, error) {
+		return types.NewSponsorship(common.BytesToAddress(contract), granter), nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QuerySponsorshipsResponse{Sponsorships: sponsorships, Pagination: pageRes}, nil
+}
@@ -0,0 +1,91 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/Asphere-xyz/tacchain/x/sponsor/types"
+)
+
+// Keeper of the sponsor store
+type Keeper struct {
+	cdc          codec.BinaryCodec
+	storeService store.KVStoreService
+	evmKeeper    types.EVMKeeper
+
+	// the address capable of executing a MsgSetSponsor message. Typically,
+	// this should be the x/gov module account.
+	authority string
+
+	Schema collections.Schema
+	// Sponsors holds the granter sponsoring every sponsored contract
+	Sponsors collections.Map[[]byte, []byte]
+}
+
+// NewKeeper returns a new sponsor keeper.
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService store.KVStoreService,
+	evmKeeper types.EVMKeeper,
+	authority string,
+) Keeper {
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
+		cdc:          cdc,
+		storeService: storeService,
+		evmKeeper:    evmKeeper,
+		authority:    authority,
+		Sponsors:     collections.NewMap(sb, types.SponsorsKey, "sponsors", collections.BytesKey, collections.BytesValue),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
+	}
+	k.Schema = schema
+
+	return k
+}
+
+// GetAuthority returns the module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetSponsor returns the granter sponsoring contract, or nil if the contract
+// isn't sponsored.
+func (k Keeper) GetSponsor(ctx context.Context, contract common.Address) (sdk.AccAddress, error) {
+	granter, err := k.Sponsors.Get(ctx, contract.Bytes())
+	if errors.Is(err, collections.ErrNotFound) {
+		return nil, nil
+	}
+	return granter, err
+}
+
+// SetSponsor sets the granter sponsoring contract.
+func (k Keeper) SetSponsor(ctx context.Context, contract common.Address, granter sdk.AccAddress) error {
+	return k.Sponsors.Set(ctx, contract.Bytes(), granter)
+}
+
+// RemoveSponsor removes the sponsor of contract.
+func (k Keeper) RemoveSponsor(ctx context.Context, contract common.Address) error {
+	return k.Sponsors.Remove(ctx, contract.Bytes())
+}
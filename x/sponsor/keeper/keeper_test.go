@@ -0,0 +1,145 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/evmos/ethermint/x/evm/statedb"
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+
+	"github.com/Asphere-xyz/tacchain/x/sponsor/keeper"
+	"github.com/Asphere-xyz/tacchain/x/sponsor/types"
+)
+
+var (
+	granter = sdk.AccAddress([]byte("granter_____________"))
+	other   = sdk.AccAddress([]byte("other_______________"))
+	// contract is created by a contract that granter created with its nonce 3,
+	// with the nonce 1 of that contract
+	contract = crypto.CreateAddress(crypto.CreateAddress(common.BytesToAddress(granter), 3), 1)
+)
+
+// mockEVMKeeper holds the EVM accounts.
+type mockEVMKeeper map[common.Address]*statedb.Account
+
+var _ types.EVMKeeper = mockEVMKeeper(nil)
+
+func (m mockEVMKeeper) GetAccount(_ sdk.Context, addr common.Address) *statedb.Account {
+	return m[addr]
+}
+
+func setupKeeper(t *testing.T) (sdk.Context, keeper.Keeper) {
+	t.Helper()
+
+	key := storetypes.NewKVStoreKey(types.StoreKey)
+	ctx := testutil.DefaultContextWithDB(t, key, storetypes.NewTransientStoreKey("transient_test")).Ctx
+	cdc := moduletestutil.MakeTestEncodingConfig().Codec
+
+	evmKeeper := mockEVMKeeper{
+		contract:                       {CodeHash: crypto.Keccak256([]byte("code"))},
+		common.BytesToAddress(granter): statedb.NewEmptyAccount(),
+	}
+	k := keeper.NewKeeper(cdc, runtime.NewKVStoreService(key), evmKeeper, "authority")
+	return ctx, k
+}
+
+func TestSponsorContract(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	_, err := msgServer.SponsorContract(ctx, types.NewMsgSponsorContract(granter.String(), "invalid", []uint64{3, 1}))
+	require.ErrorIs(t, err, types.ErrInvalidContract)
+	_, err = msgServer.SponsorContract(ctx, types.NewMsgSponsorContract(granter.String(), common.BytesToAddress(granter).Hex(), []uint64{0}))
+	require.ErrorIs(t, err, types.ErrInvalidContract)
+	_, err = msgServer.SponsorContract(ctx, types.NewMsgSponsorContract(granter.String(), contract.Hex(), nil))
+	require.ErrorIs(t, err, types.ErrInvalidContract)
+
+	// only the deployer of a contract can sponsor it
+	_, err = msgServer.SponsorContract(ctx, types.NewMsgSponsorContract(granter.String(), contract.Hex(), []uint64{3, 2}))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = msgServer.SponsorContract(ctx, types.NewMsgSponsorContract(other.String(), contract.Hex(), []uint64{3, 1}))
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = msgServer.SponsorContract(ctx, types.NewMsgSponsorContract(granter.String(), contract.Hex(), []uint64{3, 1}))
+	require.NoError(t, err)
+	sponsor, err := k.GetSponsor(ctx, contract)
+	require.NoError(t, err)
+	require.Equal(t, granter, sponsor)
+
+	// sponsoring a contract again is a no-op
+	_, err = msgServer.SponsorContract(ctx, types.NewMsgSponsorContract(granter.String(), contract.Hex(), []uint64{3, 1}))
+	require.NoError(t, err)
+
+	_, err = msgServer.UnsponsorContract(ctx, types.NewMsgUnsponsorContract(other.String(), contract.Hex()))
+	require.ErrorIs(t, err, types.ErrNotSponsor)
+	_, err = msgServer.UnsponsorContract(ctx, types.NewMsgUnsponsorContract(granter.String(), contract.Hex()))
+	require.NoError(t, err)
+	sponsor, err = k.GetSponsor(ctx, contract)
+	require.NoError(t, err)
+	require.Nil(t, sponsor)
+	_, err = msgServer.UnsponsorContract(ctx, types.NewMsgUnsponsorContract(granter.String(), contract.Hex()))
+	require.ErrorIs(t, err, types.ErrNotSponsor)
+}
+
+func TestSetSponsor(t *testing.T) {
+	ctx, k := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+	_, err := msgServer.SponsorContract(ctx, types.NewMsgSponsorContract(granter.String(), contract.Hex(), []uint64{3, 1}))
+	require.NoError(t, err)
+
+	_, err = msgServer.SetSponsor(ctx, types.NewMsgSetSponsor("not authority", contract.Hex(), other.String()))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = msgServer.SetSponsor(ctx, types.NewMsgSetSponsor("authority", contract.Hex(), other.String()))
+	require.NoError(t, err)
+	sponsor, err := k.GetSponsor(ctx, contract)
+	require.NoError(t, err)
+	require.Equal(t, other, sponsor)
+
+	// the deployer can't take the contract back from the sponsor set by governance
+	_, err = msgServer.SponsorContract(ctx, types.NewMsgSponsorContract(granter.String(), contract.Hex(), []uint64{3, 1}))
+	require.ErrorIs(t, err, types.ErrSponsored)
+
+	_, err = msgServer.SetSponsor(ctx, types.NewMsgSetSponsor("authority", contract.Hex(), ""))
+	require.NoError(t, err)
+	sponsor, err = k.GetSponsor(ctx, contract)
+	require.NoError(t, err)
+	require.Nil(t, sponsor)
+}
+
+func TestGenesis(t *testing.T) {
+	ctx, k := setupKeeper(t)
+
+	gs := types.NewGenesisState([]types.Sponsorship{
+		types.NewSponsorship(common.HexToAddress("0x1000000000000000000000000000000000000002"), other),
+		types.NewSponsorship(contract, granter),
+	})
+	require.NoError(t, gs.Validate())
+	require.NoError(t, k.InitGenesis(ctx, *gs))
+
+	exported, err := k.ExportGenesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, gs, exported)
+
+	res, err := keeper.NewQueryServerImpl(k).Sponsor(ctx, &types.QuerySponsorRequest{Contract: contract.Hex()})
+	require.NoError(t, err)
+	require.Equal(t, granter.String(), res.Granter)
+
+	duplicate := types.NewGenesisState([]types.Sponsorship{
+		types.NewSponsorship(contract, granter),
+		{Contract: "0x" + common.Bytes2Hex(contract.Bytes()), Granter: other.String()},
+	})
+	require.Error(t, duplicate.Validate())
+}
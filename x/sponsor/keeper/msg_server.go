@@ -0,0 +1,112 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	deployertypes "github.com/Asphere-xyz/tacchain/x/deployer/types"
+	"github.com/Asphere-xyz/tacchain/x/sponsor/types"
+)
+
+var _ types.MsgServer = msgServer{}
+
+type msgServer struct {
+	k Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface for
+// the provided Keeper.
+func NewMsgServerImpl(k Keeper) types.MsgServer {
+	return msgServer{k: k}
+}
+
+// SponsorContract registers the granter as the sponsor of a contract it
+// deployed. A contract can only have one sponsor, which can be replaced by
+// governance.
+func (m msgServer) SponsorContract(ctx context.Context, msg *types.MsgSponsorContract) (*types.MsgSponsorContractResponse, error) {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := types.ParseContract(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidContract, err.Error())
+	}
+	if err := deployertypes.CheckDeployer(sdk.UnwrapSDKContext(ctx), m.k.evmKeeper, contract, granter, msg.Nonces, types.ErrInvalidContract, types.ErrUnauthorized); err != nil {
+		return nil, err
+	}
+
+	sponsor, err := m.k.GetSponsor(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+	if sponsor != nil && !bytes.Equal(sponsor, granter) {
+		return nil, errorsmod.Wrapf(types.ErrSponsored, "contract %s is sponsored by %s", contract, sponsor)
+	}
+
+	if err := m.k.SetSponsor(ctx, contract, granter); err != nil {
+		return nil, err
+	}
+	return &types.MsgSponsorContractResponse{}, nil
+}
+
+// UnsponsorContract removes the granter as the sponsor of a contract.
+func (m msgServer) UnsponsorContract(ctx context.Context, msg *types.MsgUnsponsorContract) (*types.MsgUnsponsorContractResponse, error) {
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+	contract, err := types.ParseContract(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidContract, err.Error())
+	}
+
+	sponsor, err := m.k.GetSponsor(ctx, contract)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(sponsor, granter) {
+		return nil, errorsmod.Wrapf(types.ErrNotSponsor, "contract %s", contract)
+	}
+
+	if err := m.k.RemoveSponsor(ctx, contract); err != nil {
+		return nil, err
+	}
+	return &types.MsgUnsponsorContractResponse{}, nil
+}
+
+// SetSponsor replaces or removes the sponsor of a contract.
+func (m msgServer) SetSponsor(ctx context.Context, msg *types.MsgSetSponsor) (*types.MsgSetSponsorResponse, error) {
+	if m.k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.k.authority, msg.Authority)
+	}
+
+	contract, err := types.ParseContract(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidContract, err.Error())
+	}
+
+	if msg.Granter == "" {
+		if err := m.k.RemoveSponsor(ctx, contract); err != nil {
+			return nil, err
+		}
+		return &types.MsgSetSponsorResponse{}, nil
+	}
+
+	granter, err := sdk.AccAddressFromBech32(msg.Granter)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.k.SetSponsor(ctx, contract, granter); err != nil {
+		return nil, err
+	}
+	return &types.MsgSetSponsorResponse{}, nil
+}
@@ -0,0 +1,16 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import errorsmod "cosmossdk.io/errors"
+
+// x/sponsor module sentinel errors
+var (
+	ErrInvalidSigner   = errorsmod.Register(ModuleName, 2, "expected gov account as only signer for proposal message")
+	ErrInvalidContract = errorsmod.Register(ModuleName, 3, "invalid contract address")
+	ErrSponsored       = errorsmod.Register(ModuleName, 4, "contract is sponsored by another granter")
+	ErrNotSponsor      = errorsmod.Register(ModuleName, 5, "granter is not the sponsor of the contract")
+	ErrUnauthorized    = errorsmod.Register(ModuleName, 6, "granter did not deploy the contract")
+)
@@ -0,0 +1,36 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import "fmt"
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(sponsorships []Sponsorship) *GenesisState {
+	return &GenesisState{
+		Sponsorships: sponsorships,
+	}
+}
+
+// DefaultGenesisState returns the default genesis state of the sponsor module,
+// with no sponsored contracts.
+func DefaultGenesisState() *GenesisState {
+	return NewGenesisState([]Sponsorship{})
+}
+
+// Validate performs a basic validation of the genesis state.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]struct{}, len(gs.Sponsorships))
+	for _, s := range gs.Sponsorships {
+		if err := s.Validate(); err != nil {
+			return err
+		}
+		contract, _ := ParseContract(s.Contract)
+		if _, ok := seen[contract.Hex()]; ok {
+			return fmt.Errorf("duplicate sponsorship of contract %s", contract)
+		}
+		seen[contract.Hex()] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+var (
+	_ sdk.Msg = &MsgSponsorContract{}
+	_ sdk.Msg = &MsgUnsponsorContract{}
+	_ sdk.Msg = &MsgSetSponsor{}
+)
+
+// NewMsgSponsorContract creates a new MsgSponsorContract instance.
+func NewMsgSponsorContract(granter, contract string, nonces []uint64) *MsgSponsorContract {
+	return &MsgSponsorContract{
+		Granter:  granter,
+		Contract: contract,
+		Nonces:   nonces,
+	}
+}
+
+// NewMsgUnsponsorContract creates a new MsgUnsponsorContract instance.
+func NewMsgUnsponsorContract(granter, contract string) *MsgUnsponsorContract {
+	return &MsgUnsponsorContract{
+		Granter:  granter,
+		Contract: contract,
+	}
+}
+
+// NewMsgSetSponsor creates a new MsgSetSponsor instance.
+func NewMsgSetSponsor(authority, contract, granter string) *MsgSetSponsor {
+	return &MsgSetSponsor{
+		Authority: authority,
+		Contract:  contract,
+		Granter:   granter,
+	}
+}
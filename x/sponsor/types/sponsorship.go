@@ -0,0 +1,39 @@
+// SPDX-License-Identifier: BUSL-1.1-or-later
+// SPDX-FileCopyrightText: 2025 Web3 Technologies Inc. <https://asphere.xyz/>
+// Copyright (c) 2025 Web3 Technologies Inc. All rights reserved.
+// Use of this software is governed by the Business Source License included in the LICENSE file <https://github.com/Asphere-xyz/tacchain/blob/main/LICENSE>.
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// NewSponsorship creates a new Sponsorship instance.
+func NewSponsorship(contract common.Address, granter sdk.AccAddress) Sponsorship {
+	return Sponsorship{
+		Contract: contract.Hex(),
+		Granter:  granter.String(),
+	}
+}
+
+// ParseContract parses the hex address of a contract.
+func ParseContract(contract string) (common.Address, error) {
+	if !common.IsHexAddress(contract) {
+		return common.Address{}, fmt.Errorf("invalid contract address %q", contract)
+	}
+	return common.HexToAddress(contract), nil
+}
+
+// Validate performs a basic validation of the sponsorship.
+func (s Sponsorship) Validate() error {
+	if _, err := ParseContract(s.Contract); err != nil {
+		return err
+	}
+	if _, err := sdk.AccAddressFromBech32(s.Granter); err != nil {
+		return fmt.Errorf("invalid granter address %s: %w", s.Granter, err)
+	}
+	return nil
+}